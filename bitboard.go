@@ -0,0 +1,162 @@
+package cell
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Bitboard is a set of cells on an 8x8 board packed into 64 bits.
+//
+// Bit i holds the cell at row-major index i, as returned by [Board.Index]
+// on NewBoard(8, 8): a1 is bit 0, h1 is bit 7, a2 is bit 8 and h8 is bit 63.
+// "North" is towards rank 8 and "east" towards file h.
+type Bitboard uint64
+
+// File masks, one per CELL file letter.
+const (
+	FileA Bitboard = 0x0101010101010101 << iota
+	FileB
+	FileC
+	FileD
+	FileE
+	FileF
+	FileG
+	FileH
+)
+
+// Rank masks, one per CELL rank number.
+const (
+	Rank1 Bitboard = 0xFF << (8 * iota)
+	Rank2
+	Rank3
+	Rank4
+	Rank5
+	Rank6
+	Rank7
+	Rank8
+)
+
+// BitboardOf returns the bitboard holding the given coordinates.
+//
+// It panics if any coordinate does not lie on an 8x8 board.
+func BitboardOf(coords ...Coordinate) Bitboard {
+	var b Bitboard
+	for _, c := range coords {
+		b |= 1 << bitboardGrid.Index(c)
+	}
+	return b
+}
+
+// FileMask returns the cells of file i (0 is file a).
+//
+// It panics if i is outside 0 to 7.
+func FileMask(i int) Bitboard {
+	if i < 0 || i >= 8 {
+		panic("cell: index out of range")
+	}
+	return FileA << i
+}
+
+// RankMask returns the cells of rank i (0 is rank 1).
+//
+// It panics if i is outside 0 to 7.
+func RankMask(i int) Bitboard {
+	if i < 0 || i >= 8 {
+		panic("cell: index out of range")
+	}
+	return Rank1 << (8 * i)
+}
+
+// Has reports whether c is in the bitboard.
+// Coordinates outside an 8x8 board are never contained.
+func (b Bitboard) Has(c Coordinate) bool {
+	if !bitboardGrid.Contains(c) {
+		return false
+	}
+	return b&(1<<bitboardGrid.Index(c)) != 0
+}
+
+// With returns b with c added.
+//
+// It panics if c does not lie on an 8x8 board.
+func (b Bitboard) With(c Coordinate) Bitboard {
+	return b | BitboardOf(c)
+}
+
+// Without returns b with c removed.
+// Coordinates outside an 8x8 board are ignored.
+func (b Bitboard) Without(c Coordinate) Bitboard {
+	if !bitboardGrid.Contains(c) {
+		return b
+	}
+	return b &^ BitboardOf(c)
+}
+
+// Count returns the number of cells in the bitboard.
+func (b Bitboard) Count() int {
+	return bits.OnesCount64(uint64(b))
+}
+
+// All returns an iterator over the cells in the bitboard, from a1 to h8
+// in the same order as [Board.All].
+func (b Bitboard) All() iter.Seq[Coordinate] {
+	return func(yield func(Coordinate) bool) {
+		for b != 0 {
+			if !yield(bitboardGrid.At(bits.TrailingZeros64(uint64(b)))) {
+				return
+			}
+			b &= b - 1
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Shifts
+// ----------------------------------------------------------------------------
+
+// North returns every cell moved one rank up; cells on rank 8 are dropped.
+func (b Bitboard) North() Bitboard {
+	return b << 8
+}
+
+// South returns every cell moved one rank down; cells on rank 1 are dropped.
+func (b Bitboard) South() Bitboard {
+	return b >> 8
+}
+
+// East returns every cell moved one file right; cells on file h are dropped.
+func (b Bitboard) East() Bitboard {
+	return (b &^ FileH) << 1
+}
+
+// West returns every cell moved one file left; cells on file a are dropped.
+func (b Bitboard) West() Bitboard {
+	return (b &^ FileA) >> 1
+}
+
+// NorthEast returns every cell moved diagonally up and right.
+func (b Bitboard) NorthEast() Bitboard {
+	return (b &^ FileH) << 9
+}
+
+// NorthWest returns every cell moved diagonally up and left.
+func (b Bitboard) NorthWest() Bitboard {
+	return (b &^ FileA) << 7
+}
+
+// SouthEast returns every cell moved diagonally down and right.
+func (b Bitboard) SouthEast() Bitboard {
+	return (b &^ FileH) >> 7
+}
+
+// SouthWest returns every cell moved diagonally down and left.
+func (b Bitboard) SouthWest() Bitboard {
+	return (b &^ FileA) >> 9
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+// bitboardGrid is the board whose row-major indices number bitboard bits.
+var bitboardGrid = NewBoard(8, 8)
@@ -0,0 +1,161 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// Conversion
+// ----------------------------------------------------------------------------
+
+func TestBitboardOf(t *testing.T) {
+	tests := []struct {
+		inputs []string
+		want   Bitboard
+	}{
+		{nil, 0},
+		{[]string{"a1"}, 1},
+		{[]string{"h1"}, 1 << 7},
+		{[]string{"a2"}, 1 << 8},
+		{[]string{"h8"}, 1 << 63},
+		{[]string{"a1", "h8"}, 1 | 1<<63},
+	}
+
+	for _, tt := range tests {
+		if got := BitboardOf(parseAll(tt.inputs...)...); got != tt.want {
+			t.Errorf("BitboardOf(%v) = %#x, want %#x", tt.inputs, uint64(got), uint64(tt.want))
+		}
+	}
+}
+
+func TestBitboardOf_Panics(t *testing.T) {
+	for _, s := range []string{"i1", "a9", "a1A", "a"} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("BitboardOf(%s) did not panic", s)
+				}
+			}()
+			BitboardOf(MustParse(s))
+		}()
+	}
+}
+
+func TestBitboard_HasWithWithout(t *testing.T) {
+	e4 := MustParse("e4")
+	b := Bitboard(0).With(e4).With(MustParse("d5"))
+
+	if !b.Has(e4) || !b.Has(MustParse("d5")) || b.Has(MustParse("e5")) {
+		t.Errorf("Has() inconsistent with With() for %#x", uint64(b))
+	}
+	if b.Has(MustParse("z99")) {
+		t.Error("Has(z99) = true, want false")
+	}
+	if b = b.Without(e4).Without(MustParse("z99")); b.Has(e4) || b.Count() != 1 {
+		t.Errorf("Without(e4) = %#x", uint64(b))
+	}
+}
+
+func TestBitboard_All(t *testing.T) {
+	b := BitboardOf(parseAll("h8", "a1", "e4", "b1")...)
+
+	got := collectStrings(b.All())
+	want := []string{"a1", "b1", "e4", "h8"}
+	if !equalStrings(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	if b.Count() != 4 {
+		t.Errorf("Count() = %d, want 4", b.Count())
+	}
+}
+
+func TestBitboard_AgreesWithSet(t *testing.T) {
+	chess := NewBoard(8, 8)
+	s := NewSet(chess)
+	var b Bitboard
+
+	for c := range chess.All() {
+		if chess.Index(c)%3 == 0 {
+			s.Add(c)
+			b = b.With(c)
+		}
+	}
+	if !equalStrings(collectStrings(b.All()), collectStrings(s.All())) {
+		t.Error("Bitboard and Set disagree on the same cells")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Masks
+// ----------------------------------------------------------------------------
+
+func TestBitboard_Masks(t *testing.T) {
+	files := []Bitboard{FileA, FileB, FileC, FileD, FileE, FileF, FileG, FileH}
+	ranks := []Bitboard{Rank1, Rank2, Rank3, Rank4, Rank5, Rank6, Rank7, Rank8}
+	chess := NewBoard(8, 8)
+
+	for i := 0; i < 8; i++ {
+		if FileMask(i) != files[i] {
+			t.Errorf("FileMask(%d) = %#x, want %#x", i, uint64(FileMask(i)), uint64(files[i]))
+		}
+		if RankMask(i) != ranks[i] {
+			t.Errorf("RankMask(%d) = %#x, want %#x", i, uint64(RankMask(i)), uint64(ranks[i]))
+		}
+
+		var file, rank Bitboard
+		for c := range chess.File(i) {
+			file = file.With(c)
+		}
+		for c := range chess.Rank(i) {
+			rank = rank.With(c)
+		}
+		if file != files[i] || rank != ranks[i] {
+			t.Errorf("masks for index %d disagree with Board.File/Rank", i)
+		}
+	}
+}
+
+func TestBitboard_Masks_Panics(t *testing.T) {
+	for _, fn := range []func(int) Bitboard{FileMask, RankMask} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("mask(8) did not panic")
+				}
+			}()
+			fn(8)
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Shifts
+// ----------------------------------------------------------------------------
+
+func TestBitboard_Shifts(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(Bitboard) Bitboard
+		dir  Delta
+	}{
+		{"North", Bitboard.North, NewDelta(0, 1)},
+		{"South", Bitboard.South, NewDelta(0, -1)},
+		{"East", Bitboard.East, NewDelta(1, 0)},
+		{"West", Bitboard.West, NewDelta(-1, 0)},
+		{"NorthEast", Bitboard.NorthEast, NewDelta(1, 1)},
+		{"NorthWest", Bitboard.NorthWest, NewDelta(-1, 1)},
+		{"SouthEast", Bitboard.SouthEast, NewDelta(1, -1)},
+		{"SouthWest", Bitboard.SouthWest, NewDelta(-1, -1)},
+	}
+
+	chess := NewBoard(8, 8)
+	for _, tt := range tests {
+		for c := range chess.All() {
+			var want Bitboard
+			if to, ok := chess.Add(c, tt.dir); ok {
+				want = BitboardOf(to)
+			}
+			if got := tt.fn(BitboardOf(c)); got != want {
+				t.Errorf("%s(%s) = %v, want %v", tt.name, c, collectStrings(got.All()), collectStrings(want.All()))
+			}
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package cell
+
+import (
+	"iter"
+	"strconv"
+)
+
+// Board describes the extent of each dimension of a bounded game board.
+//
+// A Board has the same dimensionality rules as [Coordinate]: 1 to 3
+// dimensions, each holding between 1 and 256 positions (indices 0 to 255).
+// Dimensions may wrap around, see [Board.WithWrap].
+//
+// The zero value is not valid; use [NewBoard] to create instances.
+type Board struct {
+	extents [MaxDimensions]uint16
+	dims    uint8
+	wrap    uint8 // bit i set: dimension i wraps around
+}
+
+// NewBoard creates a Board from 1 to 3 dimension extents.
+//
+// For example, NewBoard(8, 8) describes a chess board, NewBoard(9, 10)
+// a xiangqi board and NewBoard(5, 5, 5) a Raumschach board.
+//
+// It panics if no extents are provided, if more than 3 are given,
+// or if any extent is outside the range 1 to 256.
+func NewBoard(extents ...int) Board {
+	if len(extents) == 0 {
+		panic("cell: NewBoard requires at least one extent")
+	}
+	if len(extents) > MaxDimensions {
+		panic("cell: NewBoard accepts at most 3 extents")
+	}
+
+	var b Board
+	for i, e := range extents {
+		if e < 1 || e > MaxIndex+1 {
+			panic("cell: NewBoard extent must be between 1 and 256")
+		}
+		b.extents[i] = uint16(e)
+	}
+	b.dims = uint8(len(extents))
+	return b
+}
+
+// Dims returns the number of dimensions (1, 2, or 3).
+func (b Board) Dims() int {
+	return int(b.dims)
+}
+
+// Extent returns the number of positions along dimension i (0-indexed).
+//
+// It panics if i is out of range (i >= Dims()).
+func (b Board) Extent(i int) int {
+	if i < 0 || i >= int(b.dims) {
+		panic("cell: index out of range")
+	}
+	return int(b.extents[i])
+}
+
+// Extents returns the extent of every dimension as a slice.
+//
+// The returned slice is a copy; modifying it does not affect the Board.
+func (b Board) Extents() []int {
+	result := make([]int, b.dims)
+	for i := range result {
+		result[i] = int(b.extents[i])
+	}
+	return result
+}
+
+// Size returns the total number of cells on the board.
+func (b Board) Size() int {
+	if b.dims == 0 {
+		return 0
+	}
+	size := 1
+	for i := 0; i < int(b.dims); i++ {
+		size *= int(b.extents[i])
+	}
+	return size
+}
+
+// Contains reports whether c lies on the board.
+//
+// A coordinate whose dimensionality differs from the board's is never contained.
+func (b Board) Contains(c Coordinate) bool {
+	return b.Validate(c) == nil
+}
+
+// Validate checks that c lies on the board.
+//
+// It returns nil if c is on the board, or [ErrOutOfBounds] if c has a
+// different dimensionality or any of its indices exceeds the board's extent.
+func (b Board) Validate(c Coordinate) error {
+	if c.dims != b.dims {
+		return ErrOutOfBounds
+	}
+	for i := 0; i < int(b.dims); i++ {
+		if uint16(c.indices[i]) >= b.extents[i] {
+			return ErrOutOfBounds
+		}
+	}
+	return nil
+}
+
+// String returns the board extents separated by 'x' (e.g., "8x8", "5x5x5").
+//
+// This method implements [fmt.Stringer].
+func (b Board) String() string {
+	var buf []byte
+	for i := 0; i < int(b.dims); i++ {
+		if i > 0 {
+			buf = append(buf, 'x')
+		}
+		buf = strconv.AppendInt(buf, int64(b.extents[i]), 10)
+	}
+	return string(buf)
+}
+
+// ParseOn is like [Parse] but also checks that the coordinate lies on b.
+//
+// It returns the parsing error for malformed input, or [ErrOutOfBounds]
+// if the coordinate is well-formed but outside the board:
+//
+//	chess := cell.NewBoard(8, 8)
+//	_, err := cell.ParseOn(chess, "z99") // err == cell.ErrOutOfBounds
+func ParseOn(b Board, s string) (Coordinate, error) {
+	c, err := Parse(s)
+	if err != nil {
+		return Coordinate{}, err
+	}
+	if err := b.Validate(c); err != nil {
+		return Coordinate{}, err
+	}
+	return c, nil
+}
+
+// sameExtents reports whether a and b have the same cells, ignoring wraparound.
+func sameExtents(a, b Board) bool {
+	return a.dims == b.dims && a.extents == b.extents
+}
+
+// ----------------------------------------------------------------------------
+// Iteration
+// ----------------------------------------------------------------------------
+
+// All returns an iterator over every cell of the board.
+//
+// Cells are yielded in row-major order: the first dimension (file) varies
+// fastest, then the second (rank), then the third (layer). On a chess board
+// this is a1, b1, ..., h1, a2, ..., h8.
+func (b Board) All() iter.Seq[Coordinate] {
+	return b.slice(-1, 0)
+}
+
+// File returns an iterator over the cells whose first index equals i,
+// in the same order as [Board.All].
+//
+// It panics if i is out of range (i >= Extent(0)).
+func (b Board) File(i int) iter.Seq[Coordinate] {
+	b.checkSlice(0, i)
+	return b.slice(0, i)
+}
+
+// Rank returns an iterator over the cells whose second index equals i,
+// in the same order as [Board.All].
+//
+// It panics if the board has fewer than 2 dimensions or if i is out of range.
+func (b Board) Rank(i int) iter.Seq[Coordinate] {
+	b.checkSlice(1, i)
+	return b.slice(1, i)
+}
+
+// Layer returns an iterator over the cells whose third index equals k,
+// in the same order as [Board.All].
+//
+// It panics if the board has fewer than 3 dimensions or if k is out of range.
+func (b Board) Layer(k int) iter.Seq[Coordinate] {
+	b.checkSlice(2, k)
+	return b.slice(2, k)
+}
+
+// checkSlice panics unless dimension dim exists and value is within its extent.
+func (b Board) checkSlice(dim, value int) {
+	if dim >= int(b.dims) || value < 0 || value >= int(b.extents[dim]) {
+		panic("cell: index out of range")
+	}
+}
+
+// slice returns an iterator over the cells whose index along dimension fixed
+// equals value. A negative fixed dimension iterates the whole board.
+func (b Board) slice(fixed, value int) iter.Seq[Coordinate] {
+	return func(yield func(Coordinate) bool) {
+		if b.dims == 0 {
+			return
+		}
+		var lo, hi [MaxDimensions]int
+		for i := 0; i < int(b.dims); i++ {
+			hi[i] = int(b.extents[i])
+		}
+		if fixed >= 0 {
+			lo[fixed], hi[fixed] = value, value+1
+		}
+		eachBox(b.dims, lo, hi, yield)
+	}
+}
+
+// eachBox calls yield for every coordinate of the given dimensionality with
+// lo[i] <= index < hi[i], in row-major order (first dimension fastest).
+// It returns false if yield stopped the iteration.
+func eachBox(dims uint8, lo, hi [MaxDimensions]int, yield func(Coordinate) bool) bool {
+	// Unused dimensions iterate exactly once.
+	for i := int(dims); i < MaxDimensions; i++ {
+		lo[i], hi[i] = 0, 1
+	}
+
+	c := Coordinate{dims: dims}
+	for z := lo[2]; z < hi[2]; z++ {
+		for y := lo[1]; y < hi[1]; y++ {
+			for x := lo[0]; x < hi[0]; x++ {
+				c.indices = [MaxDimensions]uint8{uint8(x), uint8(y), uint8(z)}
+				if !yield(c) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Linear indexing
+// ----------------------------------------------------------------------------
+
+// Index returns the row-major position of c in a flat array of [Board.Size]
+// cells: the first dimension varies fastest, matching the order of [Board.All].
+// On a chess board, a1 is 0, h1 is 7, a2 is 8 and h8 is 63.
+//
+// It panics if c does not lie on the board.
+func (b Board) Index(c Coordinate) int {
+	if !b.Contains(c) {
+		panic("cell: coordinate outside board")
+	}
+	index := 0
+	for i := int(b.dims) - 1; i >= 0; i-- {
+		index = index*int(b.extents[i]) + int(c.indices[i])
+	}
+	return index
+}
+
+// At returns the coordinate at row-major position i; it is the inverse of [Board.Index].
+//
+// It panics if i is out of range (i >= Size()).
+func (b Board) At(i int) Coordinate {
+	if i < 0 || i >= b.Size() {
+		panic("cell: index out of range")
+	}
+	c := Coordinate{dims: b.dims}
+	for d := 0; d < int(b.dims); d++ {
+		e := int(b.extents[d])
+		c.indices[d] = uint8(i % e)
+		i /= e
+	}
+	return c
+}
+
+// IndexColumnMajor returns the column-major position of c in a flat array of
+// [Board.Size] cells: the last dimension varies fastest. On a chess board,
+// a1 is 0, a8 is 7, b1 is 8 and h8 is 63.
+//
+// It panics if c does not lie on the board.
+func (b Board) IndexColumnMajor(c Coordinate) int {
+	if !b.Contains(c) {
+		panic("cell: coordinate outside board")
+	}
+	index := 0
+	for i := 0; i < int(b.dims); i++ {
+		index = index*int(b.extents[i]) + int(c.indices[i])
+	}
+	return index
+}
+
+// AtColumnMajor returns the coordinate at column-major position i; it is the
+// inverse of [Board.IndexColumnMajor].
+//
+// It panics if i is out of range (i >= Size()).
+func (b Board) AtColumnMajor(i int) Coordinate {
+	if i < 0 || i >= b.Size() {
+		panic("cell: index out of range")
+	}
+	c := Coordinate{dims: b.dims}
+	for d := int(b.dims) - 1; d >= 0; d-- {
+		e := int(b.extents[d])
+		c.indices[d] = uint8(i % e)
+		i /= e
+	}
+	return c
+}
@@ -0,0 +1,410 @@
+package cell
+
+import (
+	"errors"
+	"iter"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+// NewBoard
+// ----------------------------------------------------------------------------
+
+func TestNewBoard(t *testing.T) {
+	tests := []struct {
+		extents  []int
+		wantDims int
+		wantSize int
+	}{
+		{[]int{8}, 1, 8},
+		{[]int{8, 8}, 2, 64},
+		{[]int{9, 10}, 2, 90},
+		{[]int{5, 5, 5}, 3, 125},
+		{[]int{256, 256, 256}, 3, 256 * 256 * 256},
+	}
+
+	for _, tt := range tests {
+		b := NewBoard(tt.extents...)
+		if b.Dims() != tt.wantDims {
+			t.Errorf("NewBoard(%v).Dims() = %d, want %d", tt.extents, b.Dims(), tt.wantDims)
+		}
+		if b.Size() != tt.wantSize {
+			t.Errorf("NewBoard(%v).Size() = %d, want %d", tt.extents, b.Size(), tt.wantSize)
+		}
+		for i, e := range tt.extents {
+			if b.Extent(i) != e {
+				t.Errorf("NewBoard(%v).Extent(%d) = %d, want %d", tt.extents, i, b.Extent(i), e)
+			}
+		}
+	}
+}
+
+func TestNewBoard_Panics(t *testing.T) {
+	cases := [][]int{
+		{},
+		{8, 8, 8, 8},
+		{0},
+		{8, 257},
+		{-1, 8},
+	}
+
+	for _, extents := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("NewBoard(%v) did not panic", extents)
+				}
+			}()
+			NewBoard(extents...)
+		}()
+	}
+}
+
+func TestBoard_Extents_ReturnsCopy(t *testing.T) {
+	b := NewBoard(9, 10)
+
+	extents := b.Extents()
+	extents[0] = 99
+
+	if b.Extent(0) != 9 {
+		t.Error("Modifying Extents() result affected the original Board")
+	}
+}
+
+func TestBoard_Extent_PanicsOnOutOfRange(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Board.Extent(2) on 2D board did not panic")
+		}
+	}()
+	NewBoard(8, 8).Extent(2)
+}
+
+func TestBoard_String(t *testing.T) {
+	tests := []struct {
+		board Board
+		want  string
+	}{
+		{NewBoard(8), "8"},
+		{NewBoard(8, 8), "8x8"},
+		{NewBoard(5, 5, 5), "5x5x5"},
+		{Board{}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.board.String(); got != tt.want {
+			t.Errorf("Board.String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestBoard_ZeroValue(t *testing.T) {
+	var b Board
+
+	if b.Dims() != 0 {
+		t.Errorf("Zero Board.Dims() = %d, want 0", b.Dims())
+	}
+	if b.Size() != 0 {
+		t.Errorf("Zero Board.Size() = %d, want 0", b.Size())
+	}
+	if b.Contains(NewCoordinate(0)) {
+		t.Error("Zero Board.Contains(a) = true, want false")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Contains / Validate
+// ----------------------------------------------------------------------------
+
+func TestBoard_Contains(t *testing.T) {
+	chess := NewBoard(8, 8)
+	xiangqi := NewBoard(9, 10)
+	raumschach := NewBoard(5, 5, 5)
+
+	tests := []struct {
+		board Board
+		input string
+		want  bool
+	}{
+		{chess, "a1", true},
+		{chess, "h8", true},
+		{chess, "i1", false},
+		{chess, "a9", false},
+		{chess, "z99", false},
+		{chess, "a", false},
+		{chess, "a1A", false},
+		{xiangqi, "i10", true},
+		{xiangqi, "j10", false},
+		{xiangqi, "i11", false},
+		{raumschach, "e5E", true},
+		{raumschach, "e5F", false},
+		{NewBoard(256, 256), "iv256", true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.board.Contains(MustParse(tt.input)); got != tt.want {
+			t.Errorf("NewBoard(%s).Contains(%q) = %v, want %v", tt.board, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestBoard_Validate(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	if err := chess.Validate(MustParse("e4")); err != nil {
+		t.Errorf("Validate(e4) = %v, want nil", err)
+	}
+	if err := chess.Validate(MustParse("e9")); !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("Validate(e9) = %v, want ErrOutOfBounds", err)
+	}
+	if err := chess.Validate(MustParse("e4A")); !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("Validate(e4A) = %v, want ErrOutOfBounds", err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// ParseOn
+// ----------------------------------------------------------------------------
+
+func TestParseOn(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	tests := []struct {
+		input   string
+		wantErr error
+	}{
+		{"e4", nil},
+		{"h8", nil},
+		{"z99", ErrOutOfBounds},
+		{"a1A", ErrOutOfBounds},
+		{"a0", ErrLeadingZero},
+		{"", ErrEmptyInput},
+	}
+
+	for _, tt := range tests {
+		c, err := ParseOn(chess, tt.input)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParseOn(8x8, %q) error = %v, want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && c.String() != tt.input {
+			t.Errorf("ParseOn(8x8, %q) = %q", tt.input, c.String())
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Iteration
+// ----------------------------------------------------------------------------
+
+func TestBoard_All_Order(t *testing.T) {
+	tests := []struct {
+		board Board
+		want  []string
+	}{
+		{NewBoard(3), []string{"a", "b", "c"}},
+		{NewBoard(2, 3), []string{"a1", "b1", "a2", "b2", "a3", "b3"}},
+		{NewBoard(2, 2, 2), []string{"a1A", "b1A", "a2A", "b2A", "a1B", "b1B", "a2B", "b2B"}},
+	}
+
+	for _, tt := range tests {
+		got := collectStrings(tt.board.All())
+		if !equalStrings(got, tt.want) {
+			t.Errorf("NewBoard(%s).All() = %v, want %v", tt.board, got, tt.want)
+		}
+	}
+}
+
+func TestBoard_All_CoversBoard(t *testing.T) {
+	b := NewBoard(9, 10)
+	seen := make(map[Coordinate]bool)
+
+	for c := range b.All() {
+		if !b.Contains(c) {
+			t.Fatalf("All() yielded %s outside the board", c)
+		}
+		if seen[c] {
+			t.Fatalf("All() yielded %s twice", c)
+		}
+		seen[c] = true
+	}
+
+	if len(seen) != b.Size() {
+		t.Errorf("All() yielded %d cells, want %d", len(seen), b.Size())
+	}
+}
+
+func TestBoard_All_EarlyStop(t *testing.T) {
+	n := 0
+	for range NewBoard(8, 8).All() {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("iteration ran %d times after break, want 3", n)
+	}
+}
+
+func TestBoard_All_ZeroValue(t *testing.T) {
+	for c := range (Board{}).All() {
+		t.Errorf("Zero Board.All() yielded %s", c)
+	}
+}
+
+func TestBoard_FileRankLayer(t *testing.T) {
+	chess := NewBoard(8, 8)
+	cube := NewBoard(2, 2, 2)
+
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"8x8 File(4)", collectStrings(chess.File(4)), []string{"e1", "e2", "e3", "e4", "e5", "e6", "e7", "e8"}},
+		{"8x8 Rank(3)", collectStrings(chess.Rank(3)), []string{"a4", "b4", "c4", "d4", "e4", "f4", "g4", "h4"}},
+		{"2x2x2 File(1)", collectStrings(cube.File(1)), []string{"b1A", "b2A", "b1B", "b2B"}},
+		{"2x2x2 Rank(0)", collectStrings(cube.Rank(0)), []string{"a1A", "b1A", "a1B", "b1B"}},
+		{"2x2x2 Layer(1)", collectStrings(cube.Layer(1)), []string{"a1B", "b1B", "a2B", "b2B"}},
+	}
+
+	for _, tt := range tests {
+		if !equalStrings(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestBoard_FileRankLayer_Panics(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"File(8) on 8x8", func() { NewBoard(8, 8).File(8) }},
+		{"File(-1) on 8x8", func() { NewBoard(8, 8).File(-1) }},
+		{"Rank(0) on 1D", func() { NewBoard(8).Rank(0) }},
+		{"Layer(0) on 2D", func() { NewBoard(8, 8).Layer(0) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers
+// ----------------------------------------------------------------------------
+
+func collectStrings(seq iter.Seq[Coordinate]) []string {
+	var result []string
+	for c := range seq {
+		result = append(result, c.String())
+	}
+	return result
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Linear indexing
+// ----------------------------------------------------------------------------
+
+func TestBoard_Index(t *testing.T) {
+	chess := NewBoard(8, 8)
+	raumschach := NewBoard(5, 5, 5)
+
+	tests := []struct {
+		board      Board
+		input      string
+		wantRow    int
+		wantColumn int
+	}{
+		{chess, "a1", 0, 0},
+		{chess, "h1", 7, 56},
+		{chess, "a2", 8, 1},
+		{chess, "a8", 56, 7},
+		{chess, "h8", 63, 63},
+		{NewBoard(9, 10), "i10", 89, 89},
+		{NewBoard(9, 10), "b1", 1, 10},
+		{raumschach, "b1A", 1, 25},
+		{raumschach, "a2A", 5, 5},
+		{raumschach, "a1B", 25, 1},
+		{raumschach, "e5E", 124, 124},
+		{NewBoard(7), "c", 2, 2},
+	}
+
+	for _, tt := range tests {
+		c := MustParse(tt.input)
+		if got := tt.board.Index(c); got != tt.wantRow {
+			t.Errorf("NewBoard(%s).Index(%s) = %d, want %d", tt.board, tt.input, got, tt.wantRow)
+		}
+		if got := tt.board.IndexColumnMajor(c); got != tt.wantColumn {
+			t.Errorf("NewBoard(%s).IndexColumnMajor(%s) = %d, want %d", tt.board, tt.input, got, tt.wantColumn)
+		}
+	}
+}
+
+func TestBoard_Index_RoundTrip(t *testing.T) {
+	boards := []Board{NewBoard(11), NewBoard(9, 10), NewBoard(3, 4, 5)}
+
+	for _, b := range boards {
+		i := 0
+		for c := range b.All() {
+			if got := b.Index(c); got != i {
+				t.Errorf("NewBoard(%s).Index(%s) = %d, want %d (All order)", b, c, got, i)
+			}
+			if got := b.At(i); got != c {
+				t.Errorf("NewBoard(%s).At(%d) = %s, want %s", b, i, got, c)
+			}
+			if got := b.AtColumnMajor(b.IndexColumnMajor(c)); got != c {
+				t.Errorf("NewBoard(%s) column-major round trip of %s = %s", b, c, got)
+			}
+			i++
+		}
+	}
+}
+
+func TestBoard_Index_Panics(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"Index(i1)", func() { chess.Index(MustParse("i1")) }},
+		{"Index(a1A)", func() { chess.Index(MustParse("a1A")) }},
+		{"IndexColumnMajor(a9)", func() { chess.IndexColumnMajor(MustParse("a9")) }},
+		{"At(64)", func() { chess.At(64) }},
+		{"At(-1)", func() { chess.At(-1) }},
+		{"AtColumnMajor(64)", func() { chess.AtColumnMajor(64) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("NewBoard(8, 8).%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
@@ -0,0 +1,35 @@
+package cell
+
+import "slices"
+
+// Canonical returns a canonical representative of a set of coordinates
+// under the board's symmetries, together with the transform producing it.
+//
+// Every symmetry from [Board.Symmetries] is applied to coords and each image
+// is sorted with [Compare]; the lexicographically smallest image is returned.
+// Ties are broken in favour of the transform listed first, so a set that is
+// already canonical is returned with the identity. Two sets are equivalent
+// under the board's symmetries exactly when their canonical forms are equal.
+//
+// Duplicates in coords are kept. The input slice is not modified.
+// It panics if any coordinate does not lie on the board.
+func (b Board) Canonical(coords []Coordinate) ([]Coordinate, Transform) {
+	syms := b.Symmetries()
+
+	var best []Coordinate
+	var bestT Transform
+	image := make([]Coordinate, len(coords))
+
+	for i, t := range syms {
+		for j, c := range coords {
+			image[j] = b.Apply(t, c)
+		}
+		slices.SortFunc(image, Compare)
+
+		if i == 0 || slices.CompareFunc(image, best, Compare) < 0 {
+			best = append(best[:0], image...)
+			bestT = t
+		}
+	}
+	return best, bestT
+}
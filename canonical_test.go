@@ -0,0 +1,105 @@
+package cell
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBoard_Canonical(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	got, tr := chess.Canonical(parseAll("h8"))
+	if want := parseAll("a1"); !slices.Equal(got, want) {
+		t.Errorf("Canonical(h8) = %v, want %v", got, want)
+	}
+	if back := chess.Apply(tr, MustParse("h8")); back.String() != "a1" {
+		t.Errorf("Canonical(h8) transform maps h8 to %s, want a1", back)
+	}
+}
+
+func TestBoard_Canonical_Equivalence(t *testing.T) {
+	chess := NewBoard(8, 8)
+	set := parseAll("b1", "g1", "e4")
+
+	want, _ := chess.Canonical(set)
+	for _, s := range chess.Symmetries() {
+		image := make([]Coordinate, len(set))
+		for i, c := range set {
+			image[i] = chess.Apply(s, c)
+		}
+		got, tr := chess.Canonical(image)
+		if !slices.Equal(got, want) {
+			t.Errorf("Canonical(%v) = %v, want %v", image, got, want)
+		}
+
+		// The returned transform maps the input onto the canonical form.
+		mapped := make([]Coordinate, len(image))
+		for i, c := range image {
+			mapped[i] = chess.Apply(tr, c)
+		}
+		slices.SortFunc(mapped, Compare)
+		if !slices.Equal(mapped, got) {
+			t.Errorf("Canonical(%v) transform yields %v, want %v", image, mapped, got)
+		}
+	}
+}
+
+func TestBoard_Canonical_AlreadyCanonical(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	got, tr := chess.Canonical(parseAll("a1", "h8"))
+	if !tr.IsIdentity() {
+		t.Error("Canonical of a canonical set did not return the identity")
+	}
+	if want := parseAll("a1", "h8"); !slices.Equal(got, want) {
+		t.Errorf("Canonical(a1, h8) = %v, want %v", got, want)
+	}
+}
+
+func TestBoard_Canonical_RectangularBoard(t *testing.T) {
+	xiangqi := NewBoard(9, 10)
+
+	// e10 is the mirror of e1 across the river; a transpose is not a symmetry.
+	got, _ := xiangqi.Canonical(parseAll("e10"))
+	if want := parseAll("e1"); !slices.Equal(got, want) {
+		t.Errorf("Canonical(e10) on 9x10 = %v, want %v", got, want)
+	}
+}
+
+func TestBoard_Canonical_3D(t *testing.T) {
+	cube := NewBoard(5, 5, 5)
+
+	got, _ := cube.Canonical(parseAll("e5E", "c3C"))
+	if want := parseAll("a1A", "c3C"); !slices.Equal(got, want) {
+		t.Errorf("Canonical(e5E, c3C) = %v, want %v", got, want)
+	}
+}
+
+func TestBoard_Canonical_DoesNotModifyInput(t *testing.T) {
+	chess := NewBoard(8, 8)
+	set := parseAll("h8", "g7")
+
+	chess.Canonical(set)
+	if want := parseAll("h8", "g7"); !slices.Equal(set, want) {
+		t.Errorf("Canonical modified its input: %v", set)
+	}
+}
+
+func TestBoard_Canonical_Empty(t *testing.T) {
+	got, tr := NewBoard(8, 8).Canonical(nil)
+	if len(got) != 0 || !tr.IsIdentity() {
+		t.Errorf("Canonical(nil) = %v, %v", got, tr)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers
+// ----------------------------------------------------------------------------
+
+func parseAll(inputs ...string) []Coordinate {
+	result := make([]Coordinate, len(inputs))
+	for i, s := range inputs {
+		result[i] = MustParse(s)
+	}
+	return result
+}
@@ -40,21 +40,38 @@
 // Use [Validate] for detailed errors or [IsValid] for a simple boolean check:
 //
 //	if err := cell.Validate("a0"); err != nil {
-//	    fmt.Println(err) // "cell: leading zero in number"
+//	    fmt.Println(err) // `cell: parsing "a0": leading zero in number at offset 1`
 //	}
 //
 //	if cell.IsValid("e4") {
 //	    // valid coordinate
 //	}
 //
+// # Boards
+//
+// Use [Board] to describe the extent of each dimension and check bounds:
+//
+//	chess := cell.NewBoard(8, 8)
+//	chess.Contains(cell.MustParse("e4")) // true
+//
+//	_, err := cell.ParseOn(chess, "z99")
+//	fmt.Println(err) // "cell: coordinate outside board"
+//
 // # Error Handling
 //
-// All parsing errors are sentinel errors that can be checked with [errors.Is]:
+// Parsing errors are reported as [*ParseError], which records where the
+// input went wrong and wraps a sentinel error that can be checked with
+// [errors.Is]:
 //
 //	coord, err := cell.Parse(input)
 //	if errors.Is(err, cell.ErrLeadingZero) {
 //	    // handle leading zero specifically
 //	}
 //
+//	var pe *cell.ParseError
+//	if errors.As(err, &pe) {
+//	    fmt.Println(pe.Offset, pe.Dim, pe.Expected)
+//	}
+//
 // [CELL Specification v1.0.0]: https://sashite.dev/specs/cell/1.0.0/
 package cell
@@ -69,3 +69,28 @@ func (c Coordinate) At(i int) uint8 {
 func (c Coordinate) String() string {
 	return format(c)
 }
+
+// Compare returns -1, 0 or +1 depending on whether a sorts before, equal to,
+// or after b.
+//
+// Coordinates are ordered by dimensionality first, then by index from the
+// last dimension to the first. On a board this matches the order of
+// [Board.All]: a1 < b1 < a2 on a chess board.
+// Compare is suitable for [slices.SortFunc].
+func Compare(a, b Coordinate) int {
+	if a.dims != b.dims {
+		if a.dims < b.dims {
+			return -1
+		}
+		return 1
+	}
+	for i := int(a.dims) - 1; i >= 0; i-- {
+		if a.indices[i] != b.indices[i] {
+			if a.indices[i] < b.indices[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
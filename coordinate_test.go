@@ -248,3 +248,31 @@ func TestCoordinate_EqualityDifferentDims(t *testing.T) {
 		t.Error("Coordinates with different dimensions are equal")
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Compare
+// ----------------------------------------------------------------------------
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"a1", "a1", 0},
+		{"a1", "b1", -1},
+		{"h1", "a2", -1},
+		{"a2", "h1", 1},
+		{"h8", "a8", 1},
+		{"a1A", "b1A", -1},
+		{"iv256A", "a1B", -1},
+		{"iv", "a1", -1},
+		{"a1", "a1A", -1},
+		{"a1A", "iv256", 1},
+	}
+
+	for _, tt := range tests {
+		if got := Compare(MustParse(tt.a), MustParse(tt.b)); got != tt.want {
+			t.Errorf("Compare(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package cell
+
+import "strconv"
+
+// Delta represents a signed displacement between two coordinates,
+// with one offset per dimension.
+//
+// A Delta follows the same dimensionality rules as [Coordinate]:
+// it has 1 to 3 dimensions and only combines with values of equal Dims.
+//
+// The zero value is not valid; use [NewDelta] or [Coordinate.Sub] to create instances.
+type Delta struct {
+	offsets [MaxDimensions]int
+	dims    uint8
+}
+
+// NewDelta creates a Delta from 1 to 3 offsets.
+//
+// It panics if no offsets are provided or if more than 3 offsets are given.
+func NewDelta(offsets ...int) Delta {
+	if len(offsets) == 0 {
+		panic("cell: NewDelta requires at least one offset")
+	}
+	if len(offsets) > MaxDimensions {
+		panic("cell: NewDelta accepts at most 3 offsets")
+	}
+
+	var d Delta
+	d.dims = uint8(len(offsets))
+	copy(d.offsets[:], offsets)
+	return d
+}
+
+// Dims returns the number of dimensions (1, 2, or 3).
+func (d Delta) Dims() int {
+	return int(d.dims)
+}
+
+// Offsets returns the offsets as a slice.
+//
+// The returned slice is a copy; modifying it does not affect the Delta.
+func (d Delta) Offsets() []int {
+	result := make([]int, d.dims)
+	copy(result, d.offsets[:d.dims])
+	return result
+}
+
+// At returns the offset along dimension i (0-indexed).
+//
+// It panics if i is out of range (i >= Dims()).
+func (d Delta) At(i int) int {
+	if i < 0 || i >= int(d.dims) {
+		panic("cell: index out of range")
+	}
+	return d.offsets[i]
+}
+
+// IsZero reports whether every offset is zero.
+func (d Delta) IsZero() bool {
+	return d.offsets == [MaxDimensions]int{}
+}
+
+// Add returns the component-wise sum d + e.
+//
+// It panics if d and e have different dimensions.
+func (d Delta) Add(e Delta) Delta {
+	mustMatchDims(d.dims, e.dims)
+	for i := 0; i < int(d.dims); i++ {
+		d.offsets[i] += e.offsets[i]
+	}
+	return d
+}
+
+// Scale returns d with every offset multiplied by k.
+func (d Delta) Scale(k int) Delta {
+	for i := 0; i < int(d.dims); i++ {
+		d.offsets[i] *= k
+	}
+	return d
+}
+
+// Neg returns d with every offset negated.
+func (d Delta) Neg() Delta {
+	return d.Scale(-1)
+}
+
+// String returns the offsets in parentheses (e.g., "(1,-2)").
+//
+// This method implements [fmt.Stringer].
+func (d Delta) String() string {
+	buf := []byte{'('}
+	for i := 0; i < int(d.dims); i++ {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendInt(buf, int64(d.offsets[i]), 10)
+	}
+	return string(append(buf, ')'))
+}
+
+// ----------------------------------------------------------------------------
+// Coordinate arithmetic
+// ----------------------------------------------------------------------------
+
+// Add returns the coordinate displaced by d.
+//
+// The boolean is false, and the Coordinate invalid, if d has a different
+// dimensionality or if any resulting index falls outside 0 to 255.
+// Indices never wrap around.
+func (c Coordinate) Add(d Delta) (Coordinate, bool) {
+	if c.dims != d.dims {
+		return Coordinate{}, false
+	}
+	for i := 0; i < int(c.dims); i++ {
+		v := int(c.indices[i]) + d.offsets[i]
+		if v < 0 || v > MaxIndex {
+			return Coordinate{}, false
+		}
+		c.indices[i] = uint8(v)
+	}
+	return c, true
+}
+
+// Sub returns the displacement from o to c, so that o.Add(c.Sub(o)) == c.
+//
+// It panics if c and o have different dimensions.
+func (c Coordinate) Sub(o Coordinate) Delta {
+	mustMatchDims(c.dims, o.dims)
+	d := Delta{dims: c.dims}
+	for i := 0; i < int(c.dims); i++ {
+		d.offsets[i] = int(c.indices[i]) - int(o.indices[i])
+	}
+	return d
+}
+
+// mustMatchDims panics if a and b differ.
+func mustMatchDims(a, b uint8) {
+	if a != b {
+		panic("cell: dimension mismatch")
+	}
+}
@@ -0,0 +1,177 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// NewDelta
+// ----------------------------------------------------------------------------
+
+func TestNewDelta(t *testing.T) {
+	d := NewDelta(1, -2)
+
+	if d.Dims() != 2 {
+		t.Errorf("NewDelta(1, -2).Dims() = %d, want 2", d.Dims())
+	}
+	if d.At(0) != 1 || d.At(1) != -2 {
+		t.Errorf("NewDelta(1, -2) offsets = %v, want [1 -2]", d.Offsets())
+	}
+}
+
+func TestNewDelta_Panics(t *testing.T) {
+	cases := [][]int{{}, {1, 2, 3, 4}}
+
+	for _, offsets := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("NewDelta(%v) did not panic", offsets)
+				}
+			}()
+			NewDelta(offsets...)
+		}()
+	}
+}
+
+func TestDelta_Offsets_ReturnsCopy(t *testing.T) {
+	d := NewDelta(1, 2, 3)
+
+	offsets := d.Offsets()
+	offsets[0] = 99
+
+	if d.At(0) != 1 {
+		t.Error("Modifying Offsets() result affected the original Delta")
+	}
+}
+
+func TestDelta_At_PanicsOnOutOfRange(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Delta.At(2) on 2D delta did not panic")
+		}
+	}()
+	NewDelta(1, 2).At(2)
+}
+
+// ----------------------------------------------------------------------------
+// Delta arithmetic
+// ----------------------------------------------------------------------------
+
+func TestDelta_Arithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Delta
+		want Delta
+	}{
+		{"Add", NewDelta(1, 2).Add(NewDelta(3, -5)), NewDelta(4, -3)},
+		{"Scale", NewDelta(1, -2, 0).Scale(3), NewDelta(3, -6, 0)},
+		{"Neg", NewDelta(1, -2).Neg(), NewDelta(-1, 2)},
+		{"Scale zero", NewDelta(4, 7).Scale(0), NewDelta(0, 0)},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestDelta_Add_PanicsOnDimsMismatch(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Delta.Add with different dimensions did not panic")
+		}
+	}()
+	NewDelta(1, 2).Add(NewDelta(1, 2, 3))
+}
+
+func TestDelta_IsZero(t *testing.T) {
+	if !NewDelta(0, 0).IsZero() {
+		t.Error("NewDelta(0, 0).IsZero() = false, want true")
+	}
+	if NewDelta(0, 1).IsZero() {
+		t.Error("NewDelta(0, 1).IsZero() = true, want false")
+	}
+}
+
+func TestDelta_String(t *testing.T) {
+	tests := []struct {
+		delta Delta
+		want  string
+	}{
+		{NewDelta(3), "(3)"},
+		{NewDelta(1, -2), "(1,-2)"},
+		{NewDelta(0, 0, -1), "(0,0,-1)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.delta.String(); got != tt.want {
+			t.Errorf("Delta.String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Coordinate arithmetic
+// ----------------------------------------------------------------------------
+
+func TestCoordinate_Add(t *testing.T) {
+	tests := []struct {
+		from   string
+		delta  Delta
+		want   string
+		wantOK bool
+	}{
+		{"e2", NewDelta(0, 2), "e4", true},
+		{"g1", NewDelta(-1, 2), "f3", true},
+		{"c3C", NewDelta(1, 1, 1), "d4D", true},
+		{"iu", NewDelta(1), "iv", true},
+		{"a1", NewDelta(-1, 0), "", false},
+		{"a1", NewDelta(0, -1), "", false},
+		{"iv256", NewDelta(1, 0), "", false},
+		{"a256", NewDelta(0, 1), "", false},
+		{"e4", NewDelta(1, 1, 1), "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := MustParse(tt.from).Add(tt.delta)
+		if ok != tt.wantOK {
+			t.Errorf("%s.Add(%s) ok = %v, want %v", tt.from, tt.delta, ok, tt.wantOK)
+			continue
+		}
+		if ok && got.String() != tt.want {
+			t.Errorf("%s.Add(%s) = %s, want %s", tt.from, tt.delta, got, tt.want)
+		}
+	}
+}
+
+func TestCoordinate_Sub(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want Delta
+	}{
+		{"e4", "e2", NewDelta(0, 2)},
+		{"a1", "h8", NewDelta(-7, -7)},
+		{"iv256IV", "a1A", NewDelta(255, 255, 255)},
+		{"c", "c", NewDelta(0)},
+	}
+
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		got := a.Sub(b)
+		if got != tt.want {
+			t.Errorf("%s.Sub(%s) = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+		if back, ok := b.Add(got); !ok || back != a {
+			t.Errorf("%s.Add(%s.Sub(%s)) = %s, %v, want %s", tt.b, tt.a, tt.b, back, ok, tt.a)
+		}
+	}
+}
+
+func TestCoordinate_Sub_PanicsOnDimsMismatch(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Coordinate.Sub with different dimensions did not panic")
+		}
+	}()
+	MustParse("e4").Sub(MustParse("e4A"))
+}
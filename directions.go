@@ -0,0 +1,132 @@
+package cell
+
+import "iter"
+
+// Ray returns an iterator over the cells reached by repeatedly stepping from
+// from in direction dir, stopping at the edge of the board. The starting cell
+// itself is not yielded.
+//
+// On a wrapping board the ray continues across wrapped edges and stops just
+// before it would return to from.
+//
+// Nothing is yielded if dir is zero or has a different dimensionality.
+// It panics if from does not lie on the board.
+func (b Board) Ray(from Coordinate, dir Delta) iter.Seq[Coordinate] {
+	if !b.Contains(from) {
+		panic("cell: coordinate outside board")
+	}
+	return func(yield func(Coordinate) bool) {
+		if dir.IsZero() {
+			return
+		}
+		c := from
+		for {
+			var ok bool
+			if c, ok = b.Add(c, dir); !ok || c == from {
+				return
+			}
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// Standard sliding directions.
+//
+// Each function returns a new slice of unit steps for the given
+// dimensionality, in the same order as the neighbours yielded by
+// [Board.MooreNeighbors]. They are meant to be passed to [Board.Ray]:
+//
+//	for _, dir := range cell.RookDirections(2) {
+//	    for to := range chess.Ray(from, dir) {
+//	        // ...
+//	    }
+//	}
+
+// RookDirections returns the orthogonal directions, changing exactly one
+// index by 1 (2 in 1D, 4 in 2D, 6 in 3D).
+//
+// It panics if dims is not 1, 2, or 3.
+func RookDirections(dims int) []Delta {
+	return cloneSteps(orthogonalSteps, dims)
+}
+
+// BishopDirections returns the planar diagonal directions, changing exactly
+// two indices by 1 (none in 1D, 4 in 2D, 12 in 3D).
+//
+// It panics if dims is not 1, 2, or 3.
+func BishopDirections(dims int) []Delta {
+	return cloneSteps(planarSteps, dims)
+}
+
+// UnicornDirections returns the 8 triagonal directions of a 3D board,
+// changing all three indices by 1.
+func UnicornDirections() []Delta {
+	return cloneSteps(triagonalSteps, 3)
+}
+
+// QueenDirections returns every direction changing at least one index by 1
+// (2 in 1D, 8 in 2D, 26 in 3D). In 3D this is the union of the rook, bishop
+// and unicorn directions.
+//
+// It panics if dims is not 1, 2, or 3.
+func QueenDirections(dims int) []Delta {
+	return cloneSteps(kingSteps, dims)
+}
+
+// ----------------------------------------------------------------------------
+// Step tables
+// ----------------------------------------------------------------------------
+
+// Unit step tables, indexed by dimensionality.
+//
+// Each table lists the steps in {-1, 0, 1}^dims with the given number of
+// non-zero offsets, ordered so that applying them to a coordinate yields
+// neighbours in the same order as [Board.All].
+var (
+	orthogonalSteps = newStepTable(1, 1)
+	planarSteps     = newStepTable(2, 2)
+	triagonalSteps  = newStepTable(3, 3)
+	diagonalSteps   = newStepTable(2, MaxDimensions)
+	kingSteps       = newStepTable(1, MaxDimensions)
+)
+
+// stepTable holds unit steps for each dimensionality from 0 to MaxDimensions.
+type stepTable [MaxDimensions + 1][]Delta
+
+// newStepTable builds the unit steps having between minNonZero and
+// maxNonZero non-zero offsets. The first offset varies fastest, from -1 to 1.
+func newStepTable(minNonZero, maxNonZero int) stepTable {
+	var table stepTable
+	for dims := 1; dims <= MaxDimensions; dims++ {
+		total := 1
+		for i := 0; i < dims; i++ {
+			total *= 3
+		}
+		for n := 0; n < total; n++ {
+			d := Delta{dims: uint8(dims)}
+			nonZero := 0
+			for i, v := 0, n; i < dims; i, v = i+1, v/3 {
+				d.offsets[i] = v%3 - 1
+				if d.offsets[i] != 0 {
+					nonZero++
+				}
+			}
+			if nonZero >= minNonZero && nonZero <= maxNonZero {
+				table[dims] = append(table[dims], d)
+			}
+		}
+	}
+	return table
+}
+
+// cloneSteps returns a copy of the steps for dims.
+func cloneSteps(table stepTable, dims int) []Delta {
+	if dims < 1 || dims > MaxDimensions {
+		panic("cell: dimensions must be between 1 and 3")
+	}
+	result := make([]Delta, len(table[dims]))
+	copy(result, table[dims])
+	return result
+}
@@ -0,0 +1,140 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// Ray
+// ----------------------------------------------------------------------------
+
+func TestBoard_Ray(t *testing.T) {
+	chess := NewBoard(8, 8)
+	raumschach := NewBoard(5, 5, 5)
+
+	tests := []struct {
+		board Board
+		from  string
+		dir   Delta
+		want  []string
+	}{
+		{chess, "e4", NewDelta(0, 1), []string{"e5", "e6", "e7", "e8"}},
+		{chess, "e4", NewDelta(-1, -1), []string{"d3", "c2", "b1"}},
+		{chess, "a1", NewDelta(1, 1), []string{"b2", "c3", "d4", "e5", "f6", "g7", "h8"}},
+		{chess, "h8", NewDelta(1, 0), nil},
+		{chess, "a1", NewDelta(1, 2), []string{"b3", "c5", "d7"}},
+		{chess, "e4", NewDelta(0, 0), nil},
+		{chess, "e4", NewDelta(1, 1, 1), nil},
+		{raumschach, "a1A", NewDelta(1, 1, 1), []string{"b2B", "c3C", "d4D", "e5E"}},
+		{NewBoard(4), "b", NewDelta(-1), []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		got := collectStrings(tt.board.Ray(MustParse(tt.from), tt.dir))
+		if !equalStrings(got, tt.want) {
+			t.Errorf("NewBoard(%s).Ray(%s, %s) = %v, want %v", tt.board, tt.from, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestBoard_Ray_PanicsOutsideBoard(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Ray(i1) on 8x8 did not panic")
+		}
+	}()
+	NewBoard(8, 8).Ray(MustParse("i1"), NewDelta(1, 0))
+}
+
+func TestBoard_Ray_QueenMobility(t *testing.T) {
+	chess := NewBoard(8, 8)
+	n := 0
+	for _, dir := range QueenDirections(2) {
+		for range chess.Ray(MustParse("d4"), dir) {
+			n++
+		}
+	}
+	if n != 27 {
+		t.Errorf("queen on d4 reaches %d cells, want 27", n)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Direction sets
+// ----------------------------------------------------------------------------
+
+func TestDirections_Counts(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []Delta
+		want int
+	}{
+		{"RookDirections(1)", RookDirections(1), 2},
+		{"RookDirections(2)", RookDirections(2), 4},
+		{"RookDirections(3)", RookDirections(3), 6},
+		{"BishopDirections(1)", BishopDirections(1), 0},
+		{"BishopDirections(2)", BishopDirections(2), 4},
+		{"BishopDirections(3)", BishopDirections(3), 12},
+		{"UnicornDirections()", UnicornDirections(), 8},
+		{"QueenDirections(1)", QueenDirections(1), 2},
+		{"QueenDirections(2)", QueenDirections(2), 8},
+		{"QueenDirections(3)", QueenDirections(3), 26},
+	}
+
+	for _, tt := range tests {
+		if len(tt.got) != tt.want {
+			t.Errorf("len(%s) = %d, want %d", tt.name, len(tt.got), tt.want)
+		}
+	}
+}
+
+func TestDirections_Contents(t *testing.T) {
+	for _, d := range RookDirections(3) {
+		if d.Dims() != 3 || Manhattan(MustParse("b2B"), mustAdd(t, MustParse("b2B"), d)) != 1 {
+			t.Errorf("RookDirections(3) contains %s", d)
+		}
+	}
+	for _, d := range BishopDirections(2) {
+		if abs(d.At(0)) != 1 || abs(d.At(1)) != 1 {
+			t.Errorf("BishopDirections(2) contains %s", d)
+		}
+	}
+	for _, d := range UnicornDirections() {
+		if abs(d.At(0)) != 1 || abs(d.At(1)) != 1 || abs(d.At(2)) != 1 {
+			t.Errorf("UnicornDirections() contains %s", d)
+		}
+	}
+}
+
+func TestDirections_ReturnsCopy(t *testing.T) {
+	dirs := RookDirections(2)
+	dirs[0] = NewDelta(9, 9)
+
+	if RookDirections(2)[0] == NewDelta(9, 9) {
+		t.Error("Modifying RookDirections() result affected later calls")
+	}
+}
+
+func TestDirections_PanicsOnInvalidDims(t *testing.T) {
+	for _, dims := range []int{0, 4} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("QueenDirections(%d) did not panic", dims)
+				}
+			}()
+			QueenDirections(dims)
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers
+// ----------------------------------------------------------------------------
+
+func mustAdd(t *testing.T, c Coordinate, d Delta) Coordinate {
+	t.Helper()
+	n, ok := c.Add(d)
+	if !ok {
+		t.Fatalf("%s.Add(%s) failed", c, d)
+	}
+	return n
+}
@@ -0,0 +1,79 @@
+package cell
+
+// Manhattan returns the taxicab distance between a and b:
+// the sum of the absolute index differences.
+//
+// It panics if a and b have different dimensions.
+func Manhattan(a, b Coordinate) int {
+	return a.Sub(b).manhattan()
+}
+
+// Chebyshev returns the king-move distance between a and b:
+// the largest absolute index difference.
+//
+// It panics if a and b have different dimensions.
+func Chebyshev(a, b Coordinate) int {
+	return a.Sub(b).chebyshev()
+}
+
+// EuclideanSquared returns the squared Euclidean distance between a and b.
+//
+// The square is returned to keep the result an exact integer; compare
+// squared distances directly rather than taking the root.
+// It panics if a and b have different dimensions.
+func EuclideanSquared(a, b Coordinate) int {
+	return a.Sub(b).euclideanSquared()
+}
+
+// HexDistance returns the number of steps between a and b on a hexagonal
+// board using axial coordinates, where the first index is the q axis and the
+// second index is the r axis.
+//
+// It panics if a and b are not both 2-dimensional.
+func HexDistance(a, b Coordinate) int {
+	if a.dims != 2 || b.dims != 2 {
+		panic("cell: HexDistance requires 2-dimensional coordinates")
+	}
+	return a.Sub(b).hex()
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+func (d Delta) manhattan() int {
+	sum := 0
+	for i := 0; i < int(d.dims); i++ {
+		sum += abs(d.offsets[i])
+	}
+	return sum
+}
+
+func (d Delta) chebyshev() int {
+	largest := 0
+	for i := 0; i < int(d.dims); i++ {
+		largest = max(largest, abs(d.offsets[i]))
+	}
+	return largest
+}
+
+func (d Delta) euclideanSquared() int {
+	sum := 0
+	for i := 0; i < int(d.dims); i++ {
+		sum += d.offsets[i] * d.offsets[i]
+	}
+	return sum
+}
+
+// hex returns the axial hex distance of a 2-dimensional delta.
+func (d Delta) hex() int {
+	dq, dr := d.offsets[0], d.offsets[1]
+	return (abs(dq) + abs(dr) + abs(dq+dr)) / 2
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
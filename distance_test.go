@@ -0,0 +1,94 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// Distance metrics
+// ----------------------------------------------------------------------------
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b                                   string
+		manhattan, chebyshev, euclidean, hexes int
+	}{
+		{"e4", "e4", 0, 0, 0, 0},
+		{"e4", "e5", 1, 1, 1, 1},
+		{"e4", "f5", 2, 1, 2, 2},
+		{"e4", "d5", 2, 1, 2, 1},
+		{"a1", "h8", 14, 7, 98, 14},
+		{"g1", "f3", 3, 2, 5, 2},
+		{"a8", "h1", 14, 7, 98, 7},
+	}
+
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		if got := Manhattan(a, b); got != tt.manhattan {
+			t.Errorf("Manhattan(%s, %s) = %d, want %d", a, b, got, tt.manhattan)
+		}
+		if got := Chebyshev(a, b); got != tt.chebyshev {
+			t.Errorf("Chebyshev(%s, %s) = %d, want %d", a, b, got, tt.chebyshev)
+		}
+		if got := EuclideanSquared(a, b); got != tt.euclidean {
+			t.Errorf("EuclideanSquared(%s, %s) = %d, want %d", a, b, got, tt.euclidean)
+		}
+		if got := HexDistance(a, b); got != tt.hexes {
+			t.Errorf("HexDistance(%s, %s) = %d, want %d", a, b, got, tt.hexes)
+		}
+	}
+}
+
+func TestDistance_Symmetric(t *testing.T) {
+	b := NewBoard(4, 4)
+
+	for a := range b.All() {
+		for c := range b.All() {
+			if Manhattan(a, c) != Manhattan(c, a) ||
+				Chebyshev(a, c) != Chebyshev(c, a) ||
+				EuclideanSquared(a, c) != EuclideanSquared(c, a) ||
+				HexDistance(a, c) != HexDistance(c, a) {
+				t.Fatalf("distance between %s and %s is not symmetric", a, c)
+			}
+		}
+	}
+}
+
+func TestDistance_1DAnd3D(t *testing.T) {
+	a, b := MustParse("a"), MustParse("iv")
+	if got := Manhattan(a, b); got != 255 {
+		t.Errorf("Manhattan(a, iv) = %d, want 255", got)
+	}
+
+	a, b = MustParse("a1A"), MustParse("c2E")
+	if got := Manhattan(a, b); got != 7 {
+		t.Errorf("Manhattan(a1A, c2E) = %d, want 7", got)
+	}
+	if got := Chebyshev(a, b); got != 4 {
+		t.Errorf("Chebyshev(a1A, c2E) = %d, want 4", got)
+	}
+	if got := EuclideanSquared(a, b); got != 21 {
+		t.Errorf("EuclideanSquared(a1A, c2E) = %d, want 21", got)
+	}
+}
+
+func TestDistance_Panics(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"Manhattan(e4, e4A)", func() { Manhattan(MustParse("e4"), MustParse("e4A")) }},
+		{"Chebyshev(e, e4)", func() { Chebyshev(MustParse("e"), MustParse("e4")) }},
+		{"EuclideanSquared(e4A, e4)", func() { EuclideanSquared(MustParse("e4A"), MustParse("e4")) }},
+		{"HexDistance(a1A, b2B)", func() { HexDistance(MustParse("a1A"), MustParse("b2B")) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
@@ -0,0 +1,124 @@
+package cell
+
+// ----------------------------------------------------------------------------
+// Text encoding
+// ----------------------------------------------------------------------------
+
+// MarshalText returns the CELL string representation of c.
+//
+// This method implements [encoding.TextMarshaler], so a Coordinate encodes
+// as a JSON string or map key, an XML attribute, and so on.
+// It returns [ErrInvalidCoordinate] for the zero value.
+func (c Coordinate) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// AppendText appends the CELL string representation of c to b.
+//
+// This method implements [encoding.TextAppender].
+// It returns [ErrInvalidCoordinate] for the zero value.
+func (c Coordinate) AppendText(b []byte) ([]byte, error) {
+	if c.dims == 0 {
+		return b, ErrInvalidCoordinate
+	}
+	return appendFormat(b, c), nil
+}
+
+// UnmarshalText parses a CELL string into c, as [Parse] does.
+//
+// This method implements [encoding.TextUnmarshaler]; together with
+// [flag.TextVar] it also lets a Coordinate be used as a command-line flag.
+// On error, c is left unchanged.
+func (c *Coordinate) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Binary encoding
+// ----------------------------------------------------------------------------
+
+// MarshalBinary returns the compact binary form of c.
+//
+// The layout is one byte holding the number of dimensions (1 to 3) followed
+// by one byte per dimension holding its 0-indexed value, in dimension order.
+// For example, "e4" encodes as 02 04 03 and "iv256IV" as 03 FF FF FF.
+//
+// This method implements [encoding.BinaryMarshaler].
+// It returns [ErrInvalidCoordinate] for the zero value.
+func (c Coordinate) MarshalBinary() ([]byte, error) {
+	return c.AppendBinary(make([]byte, 0, 1+MaxDimensions))
+}
+
+// AppendBinary appends the binary form of c, as described in
+// [Coordinate.MarshalBinary], to b.
+//
+// This method implements [encoding.BinaryAppender].
+// It returns [ErrInvalidCoordinate] for the zero value.
+func (c Coordinate) AppendBinary(b []byte) ([]byte, error) {
+	if c.dims == 0 {
+		return b, ErrInvalidCoordinate
+	}
+	b = append(b, c.dims)
+	return append(b, c.indices[:c.dims]...), nil
+}
+
+// UnmarshalBinary decodes the binary form described in
+// [Coordinate.MarshalBinary] into c.
+//
+// This method implements [encoding.BinaryUnmarshaler]. It returns
+// [ErrInvalidEncoding] if the dimension byte is not 1 to 3 or if data does
+// not hold exactly one byte per dimension. On error, c is left unchanged.
+func (c *Coordinate) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidEncoding
+	}
+	dims := int(data[0])
+	if dims < 1 || dims > MaxDimensions || len(data) != 1+dims {
+		return ErrInvalidEncoding
+	}
+	*c = Coordinate{dims: uint8(dims)}
+	copy(c.indices[:], data[1:])
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Integer packing
+// ----------------------------------------------------------------------------
+
+// Pack returns c as a single integer key.
+//
+// The number of dimensions occupies bits 24-31 and the index of dimension i
+// occupies bits 8*i to 8*i+7; unused dimensions are zero. For example,
+// "e4" packs to 0x02000304. Packed keys sort in the same order as [Compare],
+// so they can serve as ordered keys or integer database columns.
+//
+// The zero value packs to 0, which [Unpack] rejects.
+func (c Coordinate) Pack() uint32 {
+	return uint32(c.dims)<<24 |
+		uint32(c.indices[2])<<16 |
+		uint32(c.indices[1])<<8 |
+		uint32(c.indices[0])
+}
+
+// Unpack converts a key produced by [Coordinate.Pack] back to a Coordinate.
+//
+// It returns [ErrInvalidEncoding] if the dimension count is not 1 to 3 or
+// if a byte beyond the last dimension is non-zero.
+func Unpack(key uint32) (Coordinate, error) {
+	dims := key >> 24
+	if dims < 1 || dims > MaxDimensions {
+		return Coordinate{}, ErrInvalidEncoding
+	}
+	if (key&0xFFFFFF)>>(8*dims) != 0 {
+		return Coordinate{}, ErrInvalidEncoding
+	}
+	return Coordinate{
+		indices: [MaxDimensions]uint8{uint8(key), uint8(key >> 8), uint8(key >> 16)},
+		dims:    uint8(dims),
+	}, nil
+}
@@ -0,0 +1,259 @@
+package cell
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"testing"
+)
+
+// Interface compliance.
+var (
+	_ encoding.TextMarshaler     = Coordinate{}
+	_ encoding.TextUnmarshaler   = (*Coordinate)(nil)
+	_ encoding.BinaryMarshaler   = Coordinate{}
+	_ encoding.BinaryUnmarshaler = (*Coordinate)(nil)
+)
+
+// ----------------------------------------------------------------------------
+// Text encoding
+// ----------------------------------------------------------------------------
+
+func TestCoordinate_MarshalText(t *testing.T) {
+	for _, s := range []string{"a", "e4", "a1A", "iv256IV"} {
+		got, err := MustParse(s).MarshalText()
+		if err != nil || string(got) != s {
+			t.Errorf("MarshalText(%s) = %q, %v, want %q", s, got, err, s)
+		}
+	}
+
+	if _, err := (Coordinate{}).MarshalText(); !errors.Is(err, ErrInvalidCoordinate) {
+		t.Errorf("zero Coordinate MarshalText() error = %v, want ErrInvalidCoordinate", err)
+	}
+}
+
+func TestCoordinate_AppendText(t *testing.T) {
+	buf := []byte("move:")
+	buf, err := MustParse("e4").AppendText(buf)
+	if err != nil || string(buf) != "move:e4" {
+		t.Errorf("AppendText = %q, %v, want %q", buf, err, "move:e4")
+	}
+}
+
+func TestCoordinate_UnmarshalText(t *testing.T) {
+	var c Coordinate
+	if err := c.UnmarshalText([]byte("c3C")); err != nil || c != MustParse("c3C") {
+		t.Errorf("UnmarshalText(c3C) = %s, %v", c, err)
+	}
+
+	before := c
+	if err := c.UnmarshalText([]byte("a0")); !errors.Is(err, ErrLeadingZero) {
+		t.Errorf("UnmarshalText(a0) error = %v, want ErrLeadingZero", err)
+	}
+	if c != before {
+		t.Errorf("failed UnmarshalText modified the coordinate to %s", c)
+	}
+}
+
+func TestCoordinate_JSON(t *testing.T) {
+	type move struct {
+		From Coordinate `json:"from"`
+		To   Coordinate `json:"to"`
+	}
+
+	data, err := json.Marshal(move{MustParse("e2"), MustParse("e4")})
+	if err != nil || string(data) != `{"from":"e2","to":"e4"}` {
+		t.Fatalf("json.Marshal = %s, %v", data, err)
+	}
+
+	var m move
+	if err := json.Unmarshal(data, &m); err != nil || m.From.String() != "e2" || m.To.String() != "e4" {
+		t.Errorf("json.Unmarshal = %+v, %v", m, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"from":"e0"}`), &m); !errors.Is(err, ErrLeadingZero) {
+		t.Errorf("json.Unmarshal(e0) error = %v, want ErrLeadingZero", err)
+	}
+}
+
+func TestCoordinate_JSONMapKey(t *testing.T) {
+	board := map[Coordinate]string{MustParse("e1"): "K", MustParse("e8"): "k"}
+
+	data, err := json.Marshal(board)
+	if err != nil || string(data) != `{"e1":"K","e8":"k"}` {
+		t.Fatalf("json.Marshal = %s, %v", data, err)
+	}
+
+	var back map[Coordinate]string
+	if err := json.Unmarshal(data, &back); err != nil || back[MustParse("e8")] != "k" {
+		t.Errorf("json.Unmarshal = %v, %v", back, err)
+	}
+}
+
+func TestCoordinate_XMLAttr(t *testing.T) {
+	type square struct {
+		At Coordinate `xml:"at,attr"`
+	}
+
+	data, err := xml.Marshal(square{MustParse("d5")})
+	if err != nil || string(data) != `<square at="d5"></square>` {
+		t.Fatalf("xml.Marshal = %s, %v", data, err)
+	}
+
+	var s square
+	if err := xml.Unmarshal(data, &s); err != nil || s.At.String() != "d5" {
+		t.Errorf("xml.Unmarshal = %+v, %v", s, err)
+	}
+}
+
+func TestCoordinate_FlagTextVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var c Coordinate
+	fs.TextVar(&c, "square", MustParse("a1"), "starting square")
+
+	if err := fs.Parse([]string{"-square", "h8"}); err != nil || c.String() != "h8" {
+		t.Errorf("flag -square h8 = %s, %v", c, err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Binary encoding
+// ----------------------------------------------------------------------------
+
+func TestCoordinate_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []byte
+	}{
+		{"a", []byte{1, 0}},
+		{"iv", []byte{1, 255}},
+		{"e4", []byte{2, 4, 3}},
+		{"a1A", []byte{3, 0, 0, 0}},
+		{"iv256IV", []byte{3, 255, 255, 255}},
+	}
+
+	for _, tt := range tests {
+		got, err := MustParse(tt.input).MarshalBinary()
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("MarshalBinary(%s) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+
+		var c Coordinate
+		if err := c.UnmarshalBinary(got); err != nil || c != MustParse(tt.input) {
+			t.Errorf("UnmarshalBinary(%v) = %s, %v, want %s", got, c, err, tt.input)
+		}
+	}
+
+	if _, err := (Coordinate{}).MarshalBinary(); !errors.Is(err, ErrInvalidCoordinate) {
+		t.Errorf("zero Coordinate MarshalBinary() error = %v, want ErrInvalidCoordinate", err)
+	}
+}
+
+func TestCoordinate_AppendBinary_Stream(t *testing.T) {
+	var buf []byte
+	for _, s := range []string{"e2", "e4", "c3C"} {
+		var err error
+		if buf, err = MustParse(s).AppendBinary(buf); err != nil {
+			t.Fatalf("AppendBinary(%s) error = %v", s, err)
+		}
+	}
+
+	want := []byte{2, 4, 1, 2, 4, 3, 3, 2, 2, 2}
+	if !bytes.Equal(buf, want) {
+		t.Errorf("AppendBinary stream = %v, want %v", buf, want)
+	}
+}
+
+func TestCoordinate_UnmarshalBinary_Errors(t *testing.T) {
+	cases := [][]byte{
+		nil,
+		{},
+		{0},
+		{4, 0, 0, 0, 0},
+		{2, 4},
+		{2, 4, 3, 0},
+		{1},
+		{255, 0},
+	}
+
+	for _, data := range cases {
+		c := MustParse("e4")
+		if err := c.UnmarshalBinary(data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("UnmarshalBinary(%v) error = %v, want ErrInvalidEncoding", data, err)
+		}
+		if c != MustParse("e4") {
+			t.Errorf("failed UnmarshalBinary(%v) modified the coordinate to %s", data, c)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Integer packing
+// ----------------------------------------------------------------------------
+
+func TestCoordinate_Pack(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint32
+	}{
+		{"a", 0x01000000},
+		{"iv", 0x010000FF},
+		{"e4", 0x02000304},
+		{"a1A", 0x03000000},
+		{"c3C", 0x03020202},
+		{"iv256IV", 0x03FFFFFF},
+	}
+
+	for _, tt := range tests {
+		c := MustParse(tt.input)
+		got := c.Pack()
+		if got != tt.want {
+			t.Errorf("Pack(%s) = %#08x, want %#08x", tt.input, got, tt.want)
+		}
+		back, err := Unpack(got)
+		if err != nil || back != c {
+			t.Errorf("Unpack(%#08x) = %s, %v, want %s", got, back, err, c)
+		}
+	}
+}
+
+func TestCoordinate_Pack_OrderMatchesCompare(t *testing.T) {
+	coords := parseAll("a", "b", "iv", "a1", "h1", "a2", "h8", "a256", "iv256", "a1A", "b1A", "a2A", "a1B", "iv256IV")
+
+	for _, a := range coords {
+		for _, b := range coords {
+			want := Compare(a, b)
+			var got int
+			switch pa, pb := a.Pack(), b.Pack(); {
+			case pa < pb:
+				got = -1
+			case pa > pb:
+				got = 1
+			}
+			if got != want {
+				t.Errorf("Pack order of %s vs %s = %d, Compare = %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestUnpack_Errors(t *testing.T) {
+	cases := []uint32{
+		0,
+		0x00000001,
+		0x04000000,
+		0xFF000000,
+		0x01000100,
+		0x01010000,
+		0x02010000,
+	}
+
+	for _, key := range cases {
+		if _, err := Unpack(key); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("Unpack(%#08x) error = %v, want ErrInvalidEncoding", key, err)
+		}
+	}
+}
@@ -1,10 +1,15 @@
 package cell
 
-import "errors"
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
 
 // Parsing errors.
 //
-// These sentinel errors can be checked with [errors.Is].
+// These sentinel errors are wrapped in a [*ParseError] and can be checked
+// with [errors.Is].
 var (
 	// ErrEmptyInput is returned when the input string is empty.
 	ErrEmptyInput = errors.New("cell: empty input")
@@ -27,3 +32,128 @@ var (
 	// ErrIndexOutOfRange is returned when a dimension index exceeds 255.
 	ErrIndexOutOfRange = errors.New("cell: index exceeds 255")
 )
+
+// Board errors.
+//
+// These sentinel errors can be checked with [errors.Is].
+var (
+	// ErrOutOfBounds is returned when a coordinate does not lie on a board.
+	ErrOutOfBounds = errors.New("cell: coordinate outside board")
+)
+
+// Range errors.
+//
+// These sentinel errors can be checked with [errors.Is].
+var (
+	// ErrMissingSeparator is returned when a range has no ':' between its corners.
+	ErrMissingSeparator = errors.New("cell: range missing ':' separator")
+
+	// ErrDimsMismatch is returned when the corners of a range differ in dimensionality.
+	ErrDimsMismatch = errors.New("cell: range corners differ in dimensions")
+)
+
+// Encoding errors.
+//
+// These sentinel errors can be checked with [errors.Is].
+var (
+	// ErrInvalidCoordinate is returned when encoding the zero value of Coordinate.
+	ErrInvalidCoordinate = errors.New("cell: invalid coordinate")
+
+	// ErrInvalidEncoding is returned when decoding malformed binary data.
+	ErrInvalidEncoding = errors.New("cell: invalid binary encoding")
+)
+
+// ----------------------------------------------------------------------------
+// Parse errors
+// ----------------------------------------------------------------------------
+
+// CharClass identifies the class of characters expected at a position
+// of a CELL string.
+type CharClass uint8
+
+// Character classes, in the order of CELL dimensions.
+const (
+	// ClassNone means no particular character was expected: the input
+	// should have ended, or the characters present are of the right class
+	// but form an invalid value.
+	ClassNone CharClass = iota
+
+	// ClassLower is a lowercase letter (a-z), used by the first dimension.
+	ClassLower
+
+	// ClassDigit is a decimal digit (0-9), used by the second dimension.
+	ClassDigit
+
+	// ClassUpper is an uppercase letter (A-Z), used by the third dimension.
+	ClassUpper
+)
+
+// String returns a human-readable name for the class (e.g., "digit").
+func (k CharClass) String() string {
+	switch k {
+	case ClassLower:
+		return "lowercase letter"
+	case ClassDigit:
+		return "digit"
+	case ClassUpper:
+		return "uppercase letter"
+	default:
+		return "none"
+	}
+}
+
+// ParseError describes why and where a CELL string failed validation.
+//
+// It wraps one of the parsing sentinel errors, so [errors.Is] keeps working:
+//
+//	_, err := cell.Parse("a1!")
+//	errors.Is(err, cell.ErrUnexpectedChar) // true
+//
+//	var pe *cell.ParseError
+//	if errors.As(err, &pe) {
+//	    fmt.Println(pe.Offset, pe.Expected) // 2 uppercase letter
+//	}
+type ParseError struct {
+	// Input is the string being parsed.
+	Input string
+
+	// Offset is the byte offset in Input where validation failed.
+	// For ErrIndexOutOfRange it is the start of the offending number or letters;
+	// for ErrInputTooLong it is the start of the whole coordinate.
+	Offset int
+
+	// Dim is the 0-indexed dimension being parsed when validation failed.
+	Dim int
+
+	// Expected is the character class expected at Offset, or ClassNone if
+	// the input should have ended or the value there is invalid
+	// (ErrLeadingZero, ErrIndexOutOfRange).
+	Expected CharClass
+
+	// Err is the underlying sentinel error (e.g., ErrLeadingZero).
+	Err error
+}
+
+// Error returns a message naming the input, the failure, and where it occurred
+// (e.g., `cell: parsing "a1!": unexpected character at offset 2, expected uppercase letter`).
+func (e *ParseError) Error() string {
+	msg := "cell: parsing " + strconv.Quote(e.Input) + ": " + strings.TrimPrefix(e.Err.Error(), "cell: ")
+	if e.Offset == 0 && (e.Err == ErrEmptyInput || e.Err == ErrInputTooLong) {
+		return msg
+	}
+	msg += " at offset " + strconv.Itoa(e.Offset)
+	if e.Expected != ClassNone {
+		msg += ", expected " + e.Expected.String()
+	}
+	return msg
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// newParseError returns a *ParseError for input s.
+func newParseError(s string, offset, dim int, expected CharClass, err error) *ParseError {
+	return &ParseError{Input: s, Offset: offset, Dim: dim, Expected: expected, Err: err}
+}
@@ -1,5 +1,7 @@
 package cell
 
+import "slices"
+
 // Format converts indices to a CELL string.
 //
 // This is a convenience function equivalent to:
@@ -19,7 +21,13 @@ func Format(indices ...uint8) string {
 func format(c Coordinate) string {
 	// Buffer sized for maximum: "iv256IV" = 7 bytes
 	var buf [MaxStringLen]byte
-	pos := 0
+	return string(appendFormat(buf[:0], c))
+}
+
+// appendFormat appends the CELL representation of c to dst.
+func appendFormat(dst []byte, c Coordinate) []byte {
+	pos := len(dst)
+	dst = slices.Grow(dst, MaxStringLen)[:pos+MaxStringLen]
 
 	for i := 0; i < int(c.dims); i++ {
 		val := c.indices[i]
@@ -27,15 +35,15 @@ func format(c Coordinate) string {
 
 		switch mode {
 		case 0: // Lowercase
-			pos += encodeLower(buf[pos:], val)
+			pos += encodeLower(dst[pos:], val)
 		case 1: // Digits
-			pos += encodeDigit(buf[pos:], val)
+			pos += encodeDigit(dst[pos:], val)
 		case 2: // Uppercase
-			pos += encodeUpper(buf[pos:], val)
+			pos += encodeUpper(dst[pos:], val)
 		}
 	}
 
-	return string(buf[:pos])
+	return dst[:pos]
 }
 
 // ----------------------------------------------------------------------------
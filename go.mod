@@ -1,3 +1,3 @@
 module github.com/sashite/cell.go/v3
 
-go 1.21
+go 1.23
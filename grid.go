@@ -0,0 +1,84 @@
+package cell
+
+import (
+	"iter"
+	"slices"
+)
+
+// Grid is a dense container holding one value of type T per cell of a board,
+// stored in a flat slice in [Board.Index] order.
+//
+// The zero value is not usable; use [NewGrid] to create instances.
+// A Grid is not safe for concurrent modification.
+type Grid[T any] struct {
+	board Board
+	cells []T
+}
+
+// NewGrid creates a Grid for the cells of b, each holding the zero value of T.
+func NewGrid[T any](b Board) *Grid[T] {
+	return &Grid[T]{
+		board: b,
+		cells: make([]T, b.Size()),
+	}
+}
+
+// Board returns the board the grid was created for.
+func (g *Grid[T]) Board() Board {
+	return g.board
+}
+
+// Get returns the value stored at c.
+//
+// It panics if c does not lie on the grid's board.
+func (g *Grid[T]) Get(c Coordinate) T {
+	return g.cells[g.board.Index(c)]
+}
+
+// Set stores v at c.
+//
+// It panics if c does not lie on the grid's board.
+func (g *Grid[T]) Set(c Coordinate, v T) {
+	g.cells[g.board.Index(c)] = v
+}
+
+// Fill stores v in every cell.
+func (g *Grid[T]) Fill(v T) {
+	for i := range g.cells {
+		g.cells[i] = v
+	}
+}
+
+// All returns an iterator over every cell and its value, in the same
+// order as [Board.All].
+func (g *Grid[T]) All() iter.Seq2[Coordinate, T] {
+	return func(yield func(Coordinate, T) bool) {
+		i := 0
+		for c := range g.board.All() {
+			if !yield(c, g.cells[i]) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Clone returns a copy of g. Values are copied as by assignment, so a grid
+// of pointers or slices shares what they refer to.
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{
+		board: g.board,
+		cells: slices.Clone(g.cells),
+	}
+}
+
+// EqualGrids reports whether a and b cover boards of the same extents and
+// hold equal values in every cell.
+func EqualGrids[T comparable](a, b *Grid[T]) bool {
+	return sameExtents(a.board, b.board) && slices.Equal(a.cells, b.cells)
+}
+
+// EqualGridsFunc is like [EqualGrids] but compares values with eq.
+func EqualGridsFunc[T any](a, b *Grid[T], eq func(T, T) bool) bool {
+	return sameExtents(a.board, b.board) && slices.EqualFunc(a.cells, b.cells, eq)
+}
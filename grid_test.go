@@ -0,0 +1,146 @@
+package cell
+
+import (
+	"slices"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+// Get / Set / Fill
+// ----------------------------------------------------------------------------
+
+func TestGrid_GetSet(t *testing.T) {
+	g := NewGrid[string](NewBoard(8, 8))
+
+	if got := g.Get(MustParse("e4")); got != "" {
+		t.Errorf("NewGrid().Get(e4) = %q, want zero value", got)
+	}
+
+	g.Set(MustParse("e1"), "K")
+	g.Set(MustParse("e8"), "k")
+
+	if got := g.Get(MustParse("e1")); got != "K" {
+		t.Errorf("Get(e1) = %q, want %q", got, "K")
+	}
+	if got := g.Get(MustParse("e8")); got != "k" {
+		t.Errorf("Get(e8) = %q, want %q", got, "k")
+	}
+	if g.Board() != NewBoard(8, 8) {
+		t.Errorf("Board() = %s, want 8x8", g.Board())
+	}
+}
+
+func TestGrid_3D(t *testing.T) {
+	b := NewBoard(5, 5, 5)
+	g := NewGrid[int](b)
+
+	for c := range b.All() {
+		g.Set(c, b.Index(c))
+	}
+	for c := range b.All() {
+		if got := g.Get(c); got != b.Index(c) {
+			t.Fatalf("Get(%s) = %d, want %d", c, got, b.Index(c))
+		}
+	}
+}
+
+func TestGrid_Fill(t *testing.T) {
+	g := NewGrid[int](NewBoard(3, 3))
+	g.Fill(7)
+
+	for c, v := range g.All() {
+		if v != 7 {
+			t.Errorf("after Fill(7): Get(%s) = %d", c, v)
+		}
+	}
+}
+
+func TestGrid_Panics(t *testing.T) {
+	g := NewGrid[int](NewBoard(8, 8))
+
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"Get(i1)", func() { g.Get(MustParse("i1")) }},
+		{"Set(a1A)", func() { g.Set(MustParse("a1A"), 1) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Iteration
+// ----------------------------------------------------------------------------
+
+func TestGrid_All(t *testing.T) {
+	g := NewGrid[int](NewBoard(2, 2))
+	g.Set(MustParse("b1"), 1)
+	g.Set(MustParse("a2"), 2)
+
+	var got []string
+	var values []int
+	for c, v := range g.All() {
+		got = append(got, c.String())
+		values = append(values, v)
+	}
+
+	if want := []string{"a1", "b1", "a2", "b2"}; !equalStrings(got, want) {
+		t.Errorf("All() coordinates = %v, want %v", got, want)
+	}
+	if values[0] != 0 || values[1] != 1 || values[2] != 2 || values[3] != 0 {
+		t.Errorf("All() values = %v, want [0 1 2 0]", values)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Clone / equality
+// ----------------------------------------------------------------------------
+
+func TestGrid_CloneAndEqual(t *testing.T) {
+	g := NewGrid[rune](NewBoard(9, 9))
+	g.Set(MustParse("e1"), 'K')
+
+	clone := g.Clone()
+	if !EqualGrids(g, clone) {
+		t.Error("Clone() is not equal to the original")
+	}
+
+	clone.Set(MustParse("e1"), 'G')
+	if g.Get(MustParse("e1")) != 'K' {
+		t.Error("modifying a clone affected the original")
+	}
+	if EqualGrids(g, clone) {
+		t.Error("grids with different values are equal")
+	}
+
+	if EqualGrids(NewGrid[rune](NewBoard(9, 9)), NewGrid[rune](NewBoard(3, 27))) {
+		t.Error("grids on different boards are equal")
+	}
+}
+
+func TestEqualGridsFunc(t *testing.T) {
+	a := NewGrid[[]int](NewBoard(2, 2))
+	b := NewGrid[[]int](NewBoard(2, 2))
+	a.Set(MustParse("a1"), []int{1, 2})
+	b.Set(MustParse("a1"), []int{1, 2})
+
+	eq := slices.Equal[[]int]
+	if !EqualGridsFunc(a, b, eq) {
+		t.Error("EqualGridsFunc() = false for equal contents")
+	}
+
+	b.Set(MustParse("b2"), []int{3})
+	if EqualGridsFunc(a, b, eq) {
+		t.Error("EqualGridsFunc() = true for different contents")
+	}
+}
@@ -0,0 +1,99 @@
+package cell
+
+import (
+	"iter"
+	"slices"
+)
+
+// LeaperDeltas returns every displacement of a leaper on a board with dims
+// dimensions: all permutations and sign combinations of steps, padded with
+// zero offsets up to dims. For example:
+//
+//	cell.LeaperDeltas(2, 1, 2) // knight: 8 moves
+//	cell.LeaperDeltas(2, 1, 3) // camel: 8 moves
+//	cell.LeaperDeltas(2, 2, 3) // zebra: 8 moves
+//	cell.LeaperDeltas(2, 1, 1) // ferz: 4 moves
+//	cell.LeaperDeltas(3, 1, 2) // 3D knight: 24 moves
+//
+// Signs of steps are ignored. Duplicates and the zero displacement are
+// removed, and the result is ordered so that, on a board without
+// wraparound, targets follow [Board.All].
+//
+// It panics if dims is not 1, 2, or 3, or if steps is empty or longer than dims.
+func LeaperDeltas(dims int, steps ...int) []Delta {
+	if dims < 1 || dims > MaxDimensions {
+		panic("cell: dimensions must be between 1 and 3")
+	}
+	if len(steps) == 0 || len(steps) > dims {
+		panic("cell: LeaperDeltas requires between 1 and dims steps")
+	}
+
+	var base [MaxDimensions]int
+	for i, s := range steps {
+		base[i] = abs(s)
+	}
+
+	var result []Delta
+	for _, perm := range permutations[dims] {
+		for signs := 0; signs < 1<<dims; signs++ {
+			d := Delta{dims: uint8(dims)}
+			for i := 0; i < dims; i++ {
+				d.offsets[i] = base[perm[i]]
+				if signs&(1<<i) != 0 {
+					d.offsets[i] = -d.offsets[i]
+				}
+			}
+			if !d.IsZero() && !slices.Contains(result, d) {
+				result = append(result, d)
+			}
+		}
+	}
+
+	slices.SortFunc(result, compareDeltas)
+	return result
+}
+
+// Leaps returns an iterator over the cells a leaper with the given steps
+// reaches from from, skipping targets outside the board. It is equivalent to:
+//
+//	b.Jumps(from, cell.LeaperDeltas(b.Dims(), steps...))
+//
+// Use [Board.Jumps] with a precomputed set to avoid rebuilding the
+// displacements on every call.
+func (b Board) Leaps(from Coordinate, steps ...int) iter.Seq[Coordinate] {
+	return b.Jumps(from, LeaperDeltas(b.Dims(), steps...))
+}
+
+// Jumps returns an iterator over from displaced by each of deltas, in the
+// order of deltas, skipping targets outside the board.
+//
+// On a wrapping board, displacements continue across the wrapped edges;
+// a target reached by more than one delta is yielded only once, at its
+// first delta, and a target equal to from is skipped.
+//
+// It panics if from does not lie on the board.
+func (b Board) Jumps(from Coordinate, deltas []Delta) iter.Seq[Coordinate] {
+	return b.steps(from, deltas)
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+// permutations lists every ordering of the dimensions, indexed by dimensionality.
+var permutations = [MaxDimensions + 1][][MaxDimensions]int{
+	1: {{0}},
+	2: {{0, 1}, {1, 0}},
+	3: {{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}},
+}
+
+// compareDeltas orders deltas of equal dimensionality with the last offset
+// most significant, matching the order of [Board.All].
+func compareDeltas(a, b Delta) int {
+	for i := int(a.dims) - 1; i >= 0; i-- {
+		if a.offsets[i] != b.offsets[i] {
+			return a.offsets[i] - b.offsets[i]
+		}
+	}
+	return 0
+}
@@ -0,0 +1,133 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// LeaperDeltas
+// ----------------------------------------------------------------------------
+
+func TestLeaperDeltas_Counts(t *testing.T) {
+	tests := []struct {
+		name  string
+		dims  int
+		steps []int
+		want  int
+	}{
+		{"wazir", 2, []int{1, 0}, 4},
+		{"wazir (short)", 2, []int{1}, 4},
+		{"ferz", 2, []int{1, 1}, 4},
+		{"knight", 2, []int{1, 2}, 8},
+		{"camel", 2, []int{1, 3}, 8},
+		{"zebra", 2, []int{2, 3}, 8},
+		{"dabbaba", 2, []int{2, 0}, 4},
+		{"1D leaper", 1, []int{2}, 2},
+		{"3D knight", 3, []int{1, 2}, 24},
+		{"3D (1,2,3)", 3, []int{1, 2, 3}, 48},
+		{"3D (1,1,1)", 3, []int{1, 1, 1}, 8},
+		{"null", 2, []int{0, 0}, 0},
+	}
+
+	for _, tt := range tests {
+		got := LeaperDeltas(tt.dims, tt.steps...)
+		if len(got) != tt.want {
+			t.Errorf("%s: len(LeaperDeltas(%d, %v)) = %d, want %d", tt.name, tt.dims, tt.steps, len(got), tt.want)
+		}
+	}
+}
+
+func TestLeaperDeltas_IgnoresSigns(t *testing.T) {
+	a := LeaperDeltas(2, 1, 2)
+	b := LeaperDeltas(2, -2, -1)
+
+	if len(a) != len(b) {
+		t.Fatalf("LeaperDeltas(2, 1, 2) and LeaperDeltas(2, -2, -1) differ in length")
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			t.Errorf("LeaperDeltas(2, 1, 2)[%d] = %s, LeaperDeltas(2, -2, -1)[%d] = %s", i, a[i], i, b[i])
+		}
+	}
+}
+
+func TestLeaperDeltas_Panics(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"dims 0", func() { LeaperDeltas(0, 1) }},
+		{"dims 4", func() { LeaperDeltas(4, 1) }},
+		{"no steps", func() { LeaperDeltas(2) }},
+		{"too many steps", func() { LeaperDeltas(2, 1, 2, 3) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("LeaperDeltas with %s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Leaps / Jumps
+// ----------------------------------------------------------------------------
+
+func TestBoard_Leaps(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	tests := []struct {
+		from  string
+		steps []int
+		want  []string
+	}{
+		{"g1", []int{1, 2}, []string{"e2", "f3", "h3"}},
+		{"d4", []int{1, 2}, []string{"c2", "e2", "b3", "f3", "b5", "f5", "c6", "e6"}},
+		{"a1", []int{1, 3}, []string{"d2", "b4"}},
+		{"a1", []int{2, 3}, []string{"d3", "c4"}},
+		{"h8", []int{8, 8}, nil},
+	}
+
+	for _, tt := range tests {
+		got := collectStrings(chess.Leaps(MustParse(tt.from), tt.steps...))
+		if !equalStrings(got, tt.want) {
+			t.Errorf("Leaps(%s, %v) = %v, want %v", tt.from, tt.steps, got, tt.want)
+		}
+	}
+}
+
+func TestBoard_Leaps_3D(t *testing.T) {
+	raumschach := NewBoard(5, 5, 5)
+
+	got := collectStrings(raumschach.Leaps(MustParse("a1A"), 1, 2))
+	want := []string{"b3A", "c2A", "a2C", "b1C", "a3B", "c1B"}
+	if len(got) != len(want) {
+		t.Fatalf("Leaps(a1A, 1, 2) = %v, want %d cells", got, len(want))
+	}
+	for _, s := range want {
+		found := false
+		for _, g := range got {
+			if g == s {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Leaps(a1A, 1, 2) = %v, missing %s", got, s)
+		}
+	}
+}
+
+func TestBoard_Jumps(t *testing.T) {
+	shogi := NewBoard(9, 9)
+	// Shogi knight moves forward only.
+	keima := []Delta{NewDelta(-1, 2), NewDelta(1, 2)}
+
+	got := collectStrings(shogi.Jumps(MustParse("b1"), keima))
+	want := []string{"a3", "c3"}
+	if !equalStrings(got, want) {
+		t.Errorf("Jumps(b1, keima) = %v, want %v", got, want)
+	}
+}
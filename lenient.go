@@ -0,0 +1,146 @@
+package cell
+
+import "strings"
+
+// LenientOption enables a normalization in [ParseLenient].
+type LenientOption func(*lenientConfig)
+
+// lenientConfig records the normalizations enabled by a set of LenientOptions.
+type lenientConfig struct {
+	enabled Normalization
+}
+
+// LenientTrimSpace makes [ParseLenient] remove leading and trailing Unicode
+// white space.
+func LenientTrimSpace() LenientOption {
+	return func(c *lenientConfig) { c.enabled |= TrimmedSpace }
+}
+
+// LenientFoldCase makes [ParseLenient] lowercase the letters of the first
+// dimension, so "E4" parses as e4.
+func LenientFoldCase() LenientOption {
+	return func(c *lenientConfig) { c.enabled |= FoldedCase }
+}
+
+// LenientLeadingZeros makes [ParseLenient] strip leading zeros from numbers,
+// so "e04" parses as e4. A number that is all zeros remains invalid.
+func LenientLeadingZeros() LenientOption {
+	return func(c *lenientConfig) { c.enabled |= StrippedZeros }
+}
+
+// LenientFullWidth makes [ParseLenient] map full-width digits and Latin letters
+// (U+FF10–U+FF19, U+FF21–U+FF3A, U+FF41–U+FF5A) to their ASCII forms,
+// so "ｅ４" parses as e4.
+func LenientFullWidth() LenientOption {
+	return func(c *lenientConfig) { c.enabled |= NarrowedWidth }
+}
+
+// Normalization is a set of changes [ParseLenient] made to its input.
+type Normalization uint8
+
+// Normalizations reported by [ParseLenient].
+const (
+	// NarrowedWidth means full-width characters were mapped to ASCII.
+	NarrowedWidth Normalization = 1 << iota
+
+	// TrimmedSpace means surrounding white space was removed.
+	TrimmedSpace
+
+	// FoldedCase means the first dimension was lowercased.
+	FoldedCase
+
+	// StrippedZeros means leading zeros were removed from a number.
+	StrippedZeros
+)
+
+// normalizations lists each Normalization in the order ParseLenient applies
+// it, with the step that performs it and its name.
+var normalizations = [...]struct {
+	flag  Normalization
+	apply func(string) string
+	name  string
+}{
+	{NarrowedWidth, narrowWidth, "width"},
+	{TrimmedSpace, strings.TrimSpace, "space"},
+	{FoldedCase, foldFirstDim, "case"},
+	{StrippedZeros, stripZeros, "zeros"},
+}
+
+// Has reports whether n includes every normalization in f.
+func (n Normalization) Has(f Normalization) bool {
+	return n&f == f
+}
+
+// String lists the normalizations in n separated by '|' (e.g., "space|case"),
+// or returns "none" if n is empty.
+func (n Normalization) String() string {
+	var names []string
+	for _, step := range normalizations {
+		if n.Has(step.flag) {
+			names = append(names, step.name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "|")
+}
+
+// ParseLenient is like [Parse] but first applies the normalizations enabled
+// by opts, in a fixed order: full-width mapping, white space trimming, case
+// folding, then leading zero stripping. With no options it behaves exactly
+// like [Parse].
+//
+// It returns the canonical coordinate together with the normalizations that
+// actually changed the input:
+//
+//	c, n, err := cell.ParseLenient(" E04 ",
+//	    cell.LenientTrimSpace(), cell.LenientFoldCase(), cell.LenientLeadingZeros())
+//	// c = e4, n = space|case|zeros, err = nil
+//
+// On failure the returned [*ParseError] describes the normalized string.
+func ParseLenient(s string, opts ...LenientOption) (Coordinate, Normalization, error) {
+	var cfg lenientConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var done Normalization
+	for _, step := range normalizations {
+		if !cfg.enabled.Has(step.flag) {
+			continue
+		}
+		if t := step.apply(s); t != s {
+			s = t
+			done |= step.flag
+		}
+	}
+
+	c, err := Parse(s)
+	if err != nil {
+		return Coordinate{}, done, err
+	}
+	return c, done, nil
+}
+
+// narrowWidth maps full-width digits and Latin letters to ASCII.
+func narrowWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '０' && r <= '９', r >= 'Ａ' && r <= 'Ｚ', r >= 'ａ' && r <= 'ｚ':
+			return r - 0xFEE0
+		}
+		return r
+	}, s)
+}
+
+// stripZeros removes leading zeros from each run of digits, keeping a single
+// zero for a run of zeros so that it is still rejected.
+func stripZeros(s string) string {
+	return mapDigitRuns(s, func(run string) string {
+		if run = strings.TrimLeft(run, "0"); run == "" {
+			return "0"
+		}
+		return run
+	})
+}
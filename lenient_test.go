@@ -0,0 +1,116 @@
+package cell
+
+import (
+	"errors"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+// ParseLenient
+// ----------------------------------------------------------------------------
+
+func TestParseLenient(t *testing.T) {
+	all := []LenientOption{LenientTrimSpace(), LenientFoldCase(), LenientLeadingZeros(), LenientFullWidth()}
+
+	tests := []struct {
+		input string
+		opts  []LenientOption
+		want  string
+		norm  Normalization
+	}{
+		{"e4", nil, "e4", 0},
+		{"e4", all, "e4", 0},
+		{" e4\t", []LenientOption{LenientTrimSpace()}, "e4", TrimmedSpace},
+		{"E4", []LenientOption{LenientFoldCase()}, "e4", FoldedCase},
+		{"A1A", []LenientOption{LenientFoldCase()}, "a1A", FoldedCase},
+		{"e04", []LenientOption{LenientLeadingZeros()}, "e4", StrippedZeros},
+		{"a001B", []LenientOption{LenientLeadingZeros()}, "a1B", StrippedZeros},
+		{"ｅ４", []LenientOption{LenientFullWidth()}, "e4", NarrowedWidth},
+		{"ａ１Ａ", []LenientOption{LenientFullWidth()}, "a1A", NarrowedWidth},
+		{"　Ｅ０４ ", all, "e4", NarrowedWidth | TrimmedSpace | FoldedCase | StrippedZeros},
+		{" E04 ", all, "e4", TrimmedSpace | FoldedCase | StrippedZeros},
+	}
+
+	for _, tt := range tests {
+		c, norm, err := ParseLenient(tt.input, tt.opts...)
+		if err != nil {
+			t.Errorf("ParseLenient(%q) error = %v, want nil", tt.input, err)
+			continue
+		}
+		if c.String() != tt.want || norm != tt.norm {
+			t.Errorf("ParseLenient(%q) = %s, %s, want %s, %s", tt.input, c, norm, tt.want, tt.norm)
+		}
+	}
+}
+
+func TestParseLenient_Errors(t *testing.T) {
+	tests := []struct {
+		input   string
+		opts    []LenientOption
+		wantErr error
+	}{
+		{" e4", nil, ErrInvalidStart},
+		{"E4", []LenientOption{LenientTrimSpace()}, ErrInvalidStart},
+		{"e04", []LenientOption{LenientFoldCase()}, ErrLeadingZero},
+		{"ｅ４", []LenientOption{LenientTrimSpace()}, ErrInvalidStart},
+		{"a0", []LenientOption{LenientLeadingZeros()}, ErrLeadingZero},
+		{"a000", []LenientOption{LenientLeadingZeros()}, ErrLeadingZero},
+		{"4e", []LenientOption{LenientTrimSpace(), LenientFoldCase()}, ErrInvalidStart},
+		{"   ", []LenientOption{LenientTrimSpace()}, ErrEmptyInput},
+	}
+
+	for _, tt := range tests {
+		_, _, err := ParseLenient(tt.input, tt.opts...)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParseLenient(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseLenient_ErrorDescribesNormalized(t *testing.T) {
+	_, norm, err := ParseLenient(" e4! ", LenientTrimSpace())
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("ParseLenient(\" e4! \") = %v, want *ParseError", err)
+	}
+	if pe.Input != "e4!" || pe.Offset != 2 {
+		t.Errorf("ParseLenient(\" e4! \") = {Input: %q, Offset: %d}, want {Input: \"e4!\", Offset: 2}", pe.Input, pe.Offset)
+	}
+	if norm != TrimmedSpace {
+		t.Errorf("ParseLenient(\" e4! \") normalization = %s, want space", norm)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Normalization
+// ----------------------------------------------------------------------------
+
+func TestNormalization_String(t *testing.T) {
+	tests := []struct {
+		norm Normalization
+		want string
+	}{
+		{0, "none"},
+		{TrimmedSpace, "space"},
+		{FoldedCase | TrimmedSpace, "space|case"},
+		{NarrowedWidth | TrimmedSpace | FoldedCase | StrippedZeros, "width|space|case|zeros"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.norm.String(); got != tt.want {
+			t.Errorf("Normalization(%d).String() = %q, want %q", tt.norm, got, tt.want)
+		}
+	}
+}
+
+func TestNormalization_Has(t *testing.T) {
+	n := TrimmedSpace | StrippedZeros
+
+	if !n.Has(TrimmedSpace) || !n.Has(StrippedZeros) || !n.Has(TrimmedSpace|StrippedZeros) {
+		t.Errorf("%s.Has() = false for a member, want true", n)
+	}
+	if n.Has(FoldedCase) || n.Has(TrimmedSpace|FoldedCase) {
+		t.Errorf("%s.Has() = true for a non-member, want false", n)
+	}
+}
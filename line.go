@@ -0,0 +1,146 @@
+package cell
+
+import "iter"
+
+// Aligned reports whether a and b lie on a common rook, bishop, or queen
+// line: every non-zero index difference has the same magnitude. This covers
+// orthogonals, diagonals and, in 3D, triagonals.
+//
+// A coordinate is not aligned with itself, and coordinates of different
+// dimensions are never aligned.
+func Aligned(a, b Coordinate) bool {
+	_, _, ok := alignment(a, b)
+	return ok
+}
+
+// Between returns an iterator over the cells strictly between a and b,
+// walking from a towards b.
+//
+// Nothing is yielded if a and b are not [Aligned] or are adjacent.
+// For example, Between("e1", "h1") yields f1 and g1, the squares that must
+// be empty for white to castle kingside.
+func Between(a, b Coordinate) iter.Seq[Coordinate] {
+	return func(yield func(Coordinate) bool) {
+		step, n, ok := alignment(a, b)
+		if !ok {
+			return
+		}
+		c := a
+		for i := 1; i < n; i++ {
+			c, _ = c.Add(step)
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// Collinear reports whether a, b and c lie on a single straight line,
+// in any direction. Any two coordinates, or a repeated one, are collinear
+// with a third as long as all three have the same dimensionality.
+//
+// Coordinates of different dimensions are never collinear.
+func Collinear(a, b, c Coordinate) bool {
+	if a.dims != b.dims || a.dims != c.dims {
+		return false
+	}
+	u, v := b.Sub(a), c.Sub(a)
+
+	// u and v are parallel when every 2x2 minor of the matrix [u v] vanishes.
+	for i := 0; i < int(a.dims); i++ {
+		for j := i + 1; j < int(a.dims); j++ {
+			if u.offsets[i]*v.offsets[j] != u.offsets[j]*v.offsets[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Endpoints selects which ends of a [Line] are yielded.
+type Endpoints uint8
+
+// Endpoint selections for [Line].
+const (
+	// IncludeFrom yields the starting coordinate.
+	IncludeFrom Endpoints = 1 << iota
+
+	// IncludeTo yields the ending coordinate.
+	IncludeTo
+
+	// IncludeBoth yields both ends.
+	IncludeBoth = IncludeFrom | IncludeTo
+
+	// ExcludeBoth yields only the cells strictly between the ends.
+	ExcludeBoth Endpoints = 0
+)
+
+// Line returns an iterator over the cells crossed by the straight line from
+// from to to, in order, for line-of-sight checks on any angle.
+//
+// The line takes one cell per step along its longest axis, choosing on the
+// other axes the index nearest the ideal line, as in Bresenham's algorithm.
+// Exact halves round toward the lower index, so the line from to to from
+// crosses the same cells in reverse order and line of sight is symmetric.
+// Between aligned coordinates it visits the same cells as [Between].
+//
+// Nothing is yielded if from and to have different dimensions. If they are
+// equal, the coordinate is yielded once unless ends is [ExcludeBoth].
+func Line(from, to Coordinate, ends Endpoints) iter.Seq[Coordinate] {
+	return func(yield func(Coordinate) bool) {
+		if from.dims != to.dims {
+			return
+		}
+		d := to.Sub(from)
+		n := d.chebyshev()
+		if n == 0 {
+			if ends != ExcludeBoth {
+				yield(from)
+			}
+			return
+		}
+
+		first, last := 1, n-1
+		if ends&IncludeFrom != 0 {
+			first = 0
+		}
+		if ends&IncludeTo != 0 {
+			last = n
+		}
+
+		for k := first; k <= last; k++ {
+			c := from
+			for i := 0; i < int(d.dims); i++ {
+				// Nearest integer to from+off*k/n, halves rounded down.
+				// The ideal position is never negative, so division floors.
+				pos := 2*int(from.indices[i])*n + 2*d.offsets[i]*k
+				c.indices[i] = uint8((pos + n - 1) / (2 * n))
+			}
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+// alignment returns the unit step from a towards b and the number of steps
+// between them, or false if they are not aligned.
+func alignment(a, b Coordinate) (Delta, int, bool) {
+	if a.dims != b.dims || a == b {
+		return Delta{}, 0, false
+	}
+	d := b.Sub(a)
+	n := d.chebyshev()
+	for i := 0; i < int(d.dims); i++ {
+		off := d.offsets[i]
+		if off != 0 && abs(off) != n {
+			return Delta{}, 0, false
+		}
+		d.offsets[i] = off / n
+	}
+	return d, n, true
+}
@@ -0,0 +1,210 @@
+package cell
+
+import (
+	"slices"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+// Aligned
+// ----------------------------------------------------------------------------
+
+func TestAligned(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"e1", "e8", true},
+		{"a1", "h1", true},
+		{"a1", "h8", true},
+		{"h1", "a8", true},
+		{"e4", "f5", true},
+		{"e4", "f6", false},
+		{"g1", "f3", false},
+		{"e4", "e4", false},
+		{"a1A", "e5E", true},
+		{"a1A", "a3C", true},
+		{"a1A", "a1E", true},
+		{"a1A", "b3C", false},
+		{"a", "iv", true},
+		{"a1", "a1A", false},
+	}
+
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		if got := Aligned(a, b); got != tt.want {
+			t.Errorf("Aligned(%s, %s) = %v, want %v", a, b, got, tt.want)
+		}
+		if got := Aligned(b, a); got != tt.want {
+			t.Errorf("Aligned(%s, %s) = %v, want %v", b, a, got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Between
+// ----------------------------------------------------------------------------
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want []string
+	}{
+		{"e1", "h1", []string{"f1", "g1"}},
+		{"e1", "a1", []string{"d1", "c1", "b1"}},
+		{"a1", "h8", []string{"b2", "c3", "d4", "e5", "f6", "g7"}},
+		{"d8", "d5", []string{"d7", "d6"}},
+		{"e4", "e5", nil},
+		{"e4", "e4", nil},
+		{"g1", "f3", nil},
+		{"a1A", "d4D", []string{"b2B", "c3C"}},
+		{"a", "d", []string{"b", "c"}},
+		{"a1", "c3C", nil},
+	}
+
+	for _, tt := range tests {
+		got := collectStrings(Between(MustParse(tt.a), MustParse(tt.b)))
+		if !equalStrings(got, tt.want) {
+			t.Errorf("Between(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestBetween_AgreesWithRay(t *testing.T) {
+	chess := NewBoard(8, 8)
+	from := MustParse("c3")
+
+	for _, dir := range QueenDirections(2) {
+		var walked []string
+		for to := range chess.Ray(from, dir) {
+			got := collectStrings(Between(from, to))
+			if !equalStrings(got, walked) {
+				t.Errorf("Between(%s, %s) = %v, want %v", from, to, got, walked)
+			}
+			walked = append(walked, to.String())
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Collinear
+// ----------------------------------------------------------------------------
+
+func TestCollinear(t *testing.T) {
+	tests := []struct {
+		a, b, c string
+		want    bool
+	}{
+		{"a1", "b2", "h8", true},
+		{"a1", "h8", "d4", true},
+		{"a1", "b3", "c5", true},
+		{"a1", "b3", "c6", false},
+		{"e1", "e4", "e8", true},
+		{"e1", "e4", "f8", false},
+		{"e4", "e4", "h2", true},
+		{"a1A", "b2B", "e5E", true},
+		{"a1A", "b2C", "c3E", true},
+		{"a1A", "b2C", "c3D", false},
+		{"a", "c", "iv", true},
+		{"a1", "b2", "c3C", false},
+	}
+
+	for _, tt := range tests {
+		a, b, c := MustParse(tt.a), MustParse(tt.b), MustParse(tt.c)
+		if got := Collinear(a, b, c); got != tt.want {
+			t.Errorf("Collinear(%s, %s, %s) = %v, want %v", a, b, c, got, tt.want)
+		}
+		if got := Collinear(c, a, b); got != tt.want {
+			t.Errorf("Collinear(%s, %s, %s) = %v, want %v", c, a, b, got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Line
+// ----------------------------------------------------------------------------
+
+func TestLine(t *testing.T) {
+	tests := []struct {
+		from, to string
+		ends     Endpoints
+		want     []string
+	}{
+		{"a1", "e3", IncludeBoth, []string{"a1", "b1", "c2", "d2", "e3"}},
+		{"a1", "e3", ExcludeBoth, []string{"b1", "c2", "d2"}},
+		{"a1", "e3", IncludeFrom, []string{"a1", "b1", "c2", "d2"}},
+		{"a1", "e3", IncludeTo, []string{"b1", "c2", "d2", "e3"}},
+		{"a1", "b4", IncludeBoth, []string{"a1", "a2", "b3", "b4"}},
+		{"e4", "e4", IncludeBoth, []string{"e4"}},
+		{"e4", "e4", IncludeFrom, []string{"e4"}},
+		{"e4", "e4", ExcludeBoth, nil},
+		{"e4", "e5", ExcludeBoth, nil},
+		{"a1A", "c2E", IncludeBoth, []string{"a1A", "a1B", "b1C", "b2D", "c2E"}},
+		{"a1", "c2", IncludeBoth, []string{"a1", "b1", "c2"}},
+		{"c2", "a1", IncludeBoth, []string{"c2", "b1", "a1"}},
+		{"a", "d", IncludeBoth, []string{"a", "b", "c", "d"}},
+		{"a1", "a1A", IncludeBoth, nil},
+	}
+
+	for _, tt := range tests {
+		got := collectStrings(Line(MustParse(tt.from), MustParse(tt.to), tt.ends))
+		if !equalStrings(got, tt.want) {
+			t.Errorf("Line(%s, %s, %d) = %v, want %v", tt.from, tt.to, tt.ends, got, tt.want)
+		}
+	}
+}
+
+func TestLine_AgreesWithBetween(t *testing.T) {
+	b := NewBoard(6, 6)
+
+	for a := range b.All() {
+		for c := range b.All() {
+			if !Aligned(a, c) {
+				continue
+			}
+			want := collectStrings(Between(a, c))
+			got := collectStrings(Line(a, c, ExcludeBoth))
+			if !equalStrings(got, want) {
+				t.Fatalf("Line(%s, %s, ExcludeBoth) = %v, want %v", a, c, got, want)
+			}
+		}
+	}
+}
+
+func TestLine_Symmetric(t *testing.T) {
+	b := NewBoard(6, 6)
+
+	for a := range b.All() {
+		for c := range b.All() {
+			got := collectStrings(Line(a, c, IncludeBoth))
+			back := collectStrings(Line(c, a, IncludeBoth))
+			slices.Reverse(back)
+			if !equalStrings(got, back) {
+				t.Fatalf("Line(%s, %s) = %v, reverse of Line(%s, %s) = %v", a, c, got, c, a, back)
+			}
+		}
+	}
+}
+
+func TestLine_Contiguous(t *testing.T) {
+	b := NewBoard(5, 5, 5)
+	from := MustParse("c3C")
+
+	for to := range b.All() {
+		prev := from
+		n := 0
+		for c := range Line(from, to, IncludeBoth) {
+			if n > 0 && Chebyshev(prev, c) != 1 {
+				t.Fatalf("Line(%s, %s) jumps from %s to %s", from, to, prev, c)
+			}
+			prev = c
+			n++
+		}
+		if prev != to {
+			t.Fatalf("Line(%s, %s) ends at %s", from, to, prev)
+		}
+		if n != Chebyshev(from, to)+1 {
+			t.Fatalf("Line(%s, %s) yielded %d cells, want %d", from, to, n, Chebyshev(from, to)+1)
+		}
+	}
+}
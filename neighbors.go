@@ -0,0 +1,77 @@
+package cell
+
+import (
+	"iter"
+	"slices"
+)
+
+// Neighbors returns an iterator over the von Neumann neighbourhood of c:
+// the cells sharing a face with c (2 in 1D, 4 in 2D, 6 in 3D).
+//
+// Neighbours outside the board are skipped. Without wraparound, cells are
+// yielded in the same order as [Board.All]. On a wrapping board, neighbours
+// continue across the wrapped edges, each is yielded once, and they come in
+// the order of their displacements from c, so a neighbour reached across an
+// edge keeps the place of the displacement that reaches it.
+// It panics if c does not lie on the board.
+func (b Board) Neighbors(c Coordinate) iter.Seq[Coordinate] {
+	return b.steps(c, orthogonalSteps[b.dims])
+}
+
+// MooreNeighbors returns an iterator over the Moore neighbourhood of c:
+// the cells touching c by a face, an edge or a corner (2 in 1D, 8 in 2D, 26 in 3D).
+//
+// Neighbours outside the board are skipped. Without wraparound, cells are
+// yielded in the same order as [Board.All]. On a wrapping board, neighbours
+// continue across the wrapped edges, each is yielded once, and they come in
+// the order of their displacements from c, so a neighbour reached across an
+// edge keeps the place of the displacement that reaches it.
+// It panics if c does not lie on the board.
+func (b Board) MooreNeighbors(c Coordinate) iter.Seq[Coordinate] {
+	return b.steps(c, kingSteps[b.dims])
+}
+
+// DiagonalNeighbors returns an iterator over the Moore neighbours of c that
+// are not von Neumann neighbours: the cells touching c only by an edge or a
+// corner (none in 1D, 4 in 2D, 20 in 3D).
+//
+// Neighbours outside the board are skipped. Without wraparound, cells are
+// yielded in the same order as [Board.All]. On a wrapping board, neighbours
+// continue across the wrapped edges, each is yielded once, and they come in
+// the order of their displacements from c, so a neighbour reached across an
+// edge keeps the place of the displacement that reaches it.
+// It panics if c does not lie on the board.
+func (b Board) DiagonalNeighbors(c Coordinate) iter.Seq[Coordinate] {
+	return b.steps(c, diagonalSteps[b.dims])
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+// steps returns an iterator over c displaced by each delta, skipping the
+// results that leave the board. On a wrapping board, results equal to c or
+// already yielded are skipped as well.
+func (b Board) steps(c Coordinate, deltas []Delta) iter.Seq[Coordinate] {
+	if !b.Contains(c) {
+		panic("cell: coordinate outside board")
+	}
+	return func(yield func(Coordinate) bool) {
+		var seen []Coordinate
+		for _, d := range deltas {
+			n, ok := b.Add(c, d)
+			if !ok {
+				continue
+			}
+			if b.wrap != 0 {
+				if n == c || slices.Contains(seen, n) {
+					continue
+				}
+				seen = append(seen, n)
+			}
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
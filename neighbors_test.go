@@ -0,0 +1,98 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// Neighbourhood sizes
+// ----------------------------------------------------------------------------
+
+func TestNeighbors_InteriorCounts(t *testing.T) {
+	tests := []struct {
+		board                       Board
+		center                      string
+		vonNeumann, moore, diagonal int
+	}{
+		{NewBoard(5), "c", 2, 2, 0},
+		{NewBoard(5, 5), "c3", 4, 8, 4},
+		{NewBoard(5, 5, 5), "c3C", 6, 26, 20},
+	}
+
+	for _, tt := range tests {
+		c := MustParse(tt.center)
+		if got := len(collectStrings(tt.board.Neighbors(c))); got != tt.vonNeumann {
+			t.Errorf("NewBoard(%s).Neighbors(%s) yielded %d cells, want %d", tt.board, c, got, tt.vonNeumann)
+		}
+		if got := len(collectStrings(tt.board.MooreNeighbors(c))); got != tt.moore {
+			t.Errorf("NewBoard(%s).MooreNeighbors(%s) yielded %d cells, want %d", tt.board, c, got, tt.moore)
+		}
+		if got := len(collectStrings(tt.board.DiagonalNeighbors(c))); got != tt.diagonal {
+			t.Errorf("NewBoard(%s).DiagonalNeighbors(%s) yielded %d cells, want %d", tt.board, c, got, tt.diagonal)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Neighbourhood contents
+// ----------------------------------------------------------------------------
+
+func TestNeighbors_2D(t *testing.T) {
+	chess := NewBoard(8, 8)
+	e4 := MustParse("e4")
+
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"Neighbors(e4)", collectStrings(chess.Neighbors(e4)), []string{"e3", "d4", "f4", "e5"}},
+		{"MooreNeighbors(e4)", collectStrings(chess.MooreNeighbors(e4)), []string{"d3", "e3", "f3", "d4", "f4", "d5", "e5", "f5"}},
+		{"DiagonalNeighbors(e4)", collectStrings(chess.DiagonalNeighbors(e4)), []string{"d3", "f3", "d5", "f5"}},
+	}
+
+	for _, tt := range tests {
+		if !equalStrings(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestNeighbors_ClippedToBoard(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"Neighbors(a1)", collectStrings(chess.Neighbors(MustParse("a1"))), []string{"b1", "a2"}},
+		{"MooreNeighbors(h8)", collectStrings(chess.MooreNeighbors(MustParse("h8"))), []string{"g7", "h7", "g8"}},
+		{"DiagonalNeighbors(a4)", collectStrings(chess.DiagonalNeighbors(MustParse("a4"))), []string{"b3", "b5"}},
+		{"1x1 MooreNeighbors(a1)", collectStrings(NewBoard(1, 1).MooreNeighbors(MustParse("a1"))), nil},
+	}
+
+	for _, tt := range tests {
+		if !equalStrings(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestNeighbors_3DCorner(t *testing.T) {
+	b := NewBoard(5, 5, 5)
+	corner := MustParse("a1A")
+
+	got := collectStrings(b.MooreNeighbors(corner))
+	want := []string{"b1A", "a2A", "b2A", "a1B", "b1B", "a2B", "b2B"}
+	if !equalStrings(got, want) {
+		t.Errorf("MooreNeighbors(a1A) = %v, want %v", got, want)
+	}
+}
+
+func TestNeighbors_PanicsOutsideBoard(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Neighbors(i9) on 8x8 did not panic")
+		}
+	}()
+	NewBoard(8, 8).Neighbors(MustParse("i9"))
+}
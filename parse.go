@@ -2,7 +2,7 @@ package cell
 
 // Parse converts a CELL string (e.g., "e4", "a1A") to a [Coordinate].
 //
-// It returns an error if the string is not a valid CELL coordinate.
+// It returns a [*ParseError] if the string is not a valid CELL coordinate.
 // For trusted input or constants, use [MustParse] instead.
 func Parse(s string) (Coordinate, error) {
 	if err := validate(s); err != nil {
@@ -26,8 +26,8 @@ func MustParse(s string) Coordinate {
 
 // Validate checks if s is a valid CELL coordinate.
 //
-// It returns nil if valid, or a descriptive error.
-// Use [errors.Is] to check for specific error types.
+// It returns nil if valid, or a [*ParseError] describing where validation
+// failed. Use [errors.Is] to check for specific error types.
 func Validate(s string) error {
 	return validate(s)
 }
@@ -42,19 +42,20 @@ func IsValid(s string) bool {
 // ----------------------------------------------------------------------------
 
 // validate checks if s is a valid CELL coordinate and returns a detailed error.
+// Every error is a *ParseError wrapping one of the sentinel errors.
 func validate(s string) error {
 	n := len(s)
 
 	if n == 0 {
-		return ErrEmptyInput
+		return newParseError(s, 0, 0, ClassLower, ErrEmptyInput)
 	}
 	if n > MaxStringLen {
-		return ErrInputTooLong
+		return newParseError(s, 0, 0, ClassNone, ErrInputTooLong)
 	}
 
 	// Must start with lowercase
 	if !isLower(s[0]) {
-		return ErrInvalidStart
+		return newParseError(s, 0, 0, ClassLower, ErrInvalidStart)
 	}
 
 	cursor := 0
@@ -62,11 +63,12 @@ func validate(s string) error {
 
 	for cursor < n {
 		if dim >= MaxDimensions {
-			return ErrTooManyDims
+			return newParseError(s, cursor, dim, ClassNone, ErrTooManyDims)
 		}
 
 		start := cursor
 		mode := dim % 3
+		class := CharClass(mode + 1)
 
 		switch mode {
 		case 0: // Lowercase (a-z)
@@ -75,22 +77,22 @@ func validate(s string) error {
 			}
 			// Decode and check range
 			if decodeLower(s[start:cursor]) > MaxIndex {
-				return ErrIndexOutOfRange
+				return newParseError(s, start, dim, ClassNone, ErrIndexOutOfRange)
 			}
 
 		case 1: // Digits (1-9, no leading zero)
 			if s[cursor] == '0' {
-				return ErrLeadingZero
+				return newParseError(s, cursor, dim, ClassNone, ErrLeadingZero)
 			}
 			for cursor < n && isDigit(s[cursor]) {
 				cursor++
 			}
 			if cursor == start {
-				return ErrUnexpectedChar
+				return newParseError(s, cursor, dim, class, ErrUnexpectedChar)
 			}
 			// Decode and check range
 			if decodeDigit(s[start:cursor]) > MaxIndex {
-				return ErrIndexOutOfRange
+				return newParseError(s, start, dim, ClassNone, ErrIndexOutOfRange)
 			}
 
 		case 2: // Uppercase (A-Z)
@@ -98,16 +100,16 @@ func validate(s string) error {
 				cursor++
 			}
 			if cursor == start {
-				return ErrUnexpectedChar
+				return newParseError(s, cursor, dim, class, ErrUnexpectedChar)
 			}
 			// Decode and check range
 			if decodeUpper(s[start:cursor]) > MaxIndex {
-				return ErrIndexOutOfRange
+				return newParseError(s, start, dim, ClassNone, ErrIndexOutOfRange)
 			}
 		}
 
 		if cursor == start {
-			return ErrUnexpectedChar
+			return newParseError(s, cursor, dim, class, ErrUnexpectedChar)
 		}
 
 		dim++
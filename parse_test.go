@@ -398,6 +398,93 @@ func TestParse_MaxStringLength(t *testing.T) {
 	}
 }
 
+// ----------------------------------------------------------------------------
+// ParseError
+// ----------------------------------------------------------------------------
+
+func TestValidate_ParseError(t *testing.T) {
+	tests := []struct {
+		input        string
+		wantErr      error
+		wantOffset   int
+		wantDim      int
+		wantExpected CharClass
+	}{
+		{"", ErrEmptyInput, 0, 0, ClassLower},
+		{"a1A1A1A1", ErrInputTooLong, 0, 0, ClassNone},
+		{"1a", ErrInvalidStart, 0, 0, ClassLower},
+		{"aA", ErrUnexpectedChar, 1, 1, ClassDigit},
+		{"a!", ErrUnexpectedChar, 1, 1, ClassDigit},
+		{"a1!", ErrUnexpectedChar, 2, 2, ClassUpper},
+		{"a1a", ErrUnexpectedChar, 2, 2, ClassUpper},
+		{"a0", ErrLeadingZero, 1, 1, ClassNone},
+		{"iw", ErrIndexOutOfRange, 0, 0, ClassNone},
+		{"a257", ErrIndexOutOfRange, 1, 1, ClassNone},
+		{"a1IW", ErrIndexOutOfRange, 2, 2, ClassNone},
+		{"a1Aa", ErrTooManyDims, 3, 3, ClassNone},
+	}
+
+	for _, tt := range tests {
+		err := Validate(tt.input)
+
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Validate(%q) = %v, want *ParseError", tt.input, err)
+			continue
+		}
+		if !errors.Is(err, tt.wantErr) || pe.Err != tt.wantErr {
+			t.Errorf("Validate(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+		}
+		if pe.Input != tt.input || pe.Offset != tt.wantOffset || pe.Dim != tt.wantDim || pe.Expected != tt.wantExpected {
+			t.Errorf("Validate(%q) = {Input: %q, Offset: %d, Dim: %d, Expected: %s}, want {Offset: %d, Dim: %d, Expected: %s}",
+				tt.input, pe.Input, pe.Offset, pe.Dim, pe.Expected, tt.wantOffset, tt.wantDim, tt.wantExpected)
+		}
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", `cell: parsing "": empty input`},
+		{"a1A1A1A1", `cell: parsing "a1A1A1A1": input exceeds 7 characters`},
+		{"E4", `cell: parsing "E4": must start with lowercase letter at offset 0, expected lowercase letter`},
+		{"a1!", `cell: parsing "a1!": unexpected character at offset 2, expected uppercase letter`},
+		{"a0", `cell: parsing "a0": leading zero in number at offset 1`},
+		{"e04", `cell: parsing "e04": leading zero in number at offset 1`},
+		{"zz1", `cell: parsing "zz1": index exceeds 255 at offset 0`},
+		{"a257", `cell: parsing "a257": index exceeds 255 at offset 1`},
+		{"a1IW", `cell: parsing "a1IW": index exceeds 255 at offset 2`},
+		{"a1Aa", `cell: parsing "a1Aa": exceeds 3 dimensions at offset 3`},
+		{"a\n", `cell: parsing "a\n": unexpected character at offset 1, expected digit`},
+	}
+
+	for _, tt := range tests {
+		if got := Validate(tt.input).Error(); got != tt.want {
+			t.Errorf("Validate(%q).Error() = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestCharClass_String(t *testing.T) {
+	tests := []struct {
+		class CharClass
+		want  string
+	}{
+		{ClassNone, "none"},
+		{ClassLower, "lowercase letter"},
+		{ClassDigit, "digit"},
+		{ClassUpper, "uppercase letter"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.class.String(); got != tt.want {
+			t.Errorf("CharClass(%d).String() = %q, want %q", tt.class, got, tt.want)
+		}
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers
 // ----------------------------------------------------------------------------
@@ -0,0 +1,191 @@
+package cell
+
+import (
+	"errors"
+	"iter"
+	"strings"
+)
+
+// Region represents an axis-aligned box of coordinates: a segment in 1D,
+// a rectangle in 2D or a cuboid in 3D, with both corners included.
+//
+// For example, the first player's promotion zone in shogi is the region "a7:i9".
+//
+// The zero value is not valid; use [NewRegion] to create instances.
+type Region struct {
+	min, max Coordinate
+}
+
+// NewRegion creates the Region spanned by two opposite corners, given in any order.
+//
+// It panics if a and b have different dimensions or are zero values.
+func NewRegion(a, b Coordinate) Region {
+	mustMatchDims(a.dims, b.dims)
+	if a.dims == 0 {
+		panic("cell: NewRegion requires valid coordinates")
+	}
+	r := Region{min: a, max: b}
+	for i := 0; i < int(a.dims); i++ {
+		if a.indices[i] > b.indices[i] {
+			r.min.indices[i], r.max.indices[i] = b.indices[i], a.indices[i]
+		}
+	}
+	return r
+}
+
+// ParseRange converts a CELL range (e.g., "a1:h2") to a [Region].
+//
+// The input must be two CELL coordinates of equal dimensionality separated
+// by a single colon; the corners may be given in any order. Each coordinate
+// is checked as by [Validate], with [ParseError] offsets relative to the
+// whole range. It returns [ErrMissingSeparator] if there is no colon and
+// [ErrDimsMismatch] if the corners differ in dimensionality.
+func ParseRange(s string) (Region, error) {
+	from, to, ok := strings.Cut(s, ":")
+	if !ok {
+		return Region{}, ErrMissingSeparator
+	}
+	if err := validate(from); err != nil {
+		return Region{}, rangeError(s, 0, err)
+	}
+	if err := validate(to); err != nil {
+		return Region{}, rangeError(s, len(from)+1, err)
+	}
+	a, b := parse(from), parse(to)
+	if a.dims != b.dims {
+		return Region{}, ErrDimsMismatch
+	}
+	return NewRegion(a, b), nil
+}
+
+// rangeError rebases a corner's *ParseError onto the whole range input s,
+// where the corner starts at byte offset.
+func rangeError(s string, offset int, err error) error {
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		return err
+	}
+	pe.Input = s
+	pe.Offset += offset
+	return pe
+}
+
+// MustParseRange is like [ParseRange] but panics on error.
+//
+// Use for compile-time constants or trusted input:
+//
+//	var promotionZone = cell.MustParseRange("a7:i9")
+func MustParseRange(s string) Region {
+	r, err := ParseRange(s)
+	if err != nil {
+		panic("cell: MustParseRange(" + s + "): " + err.Error())
+	}
+	return r
+}
+
+// Dims returns the number of dimensions (1, 2, or 3).
+func (r Region) Dims() int {
+	return int(r.min.dims)
+}
+
+// Min returns the corner with the smallest index along every dimension.
+func (r Region) Min() Coordinate {
+	return r.min
+}
+
+// Max returns the corner with the largest index along every dimension.
+func (r Region) Max() Coordinate {
+	return r.max
+}
+
+// Size returns the number of cells in the region.
+func (r Region) Size() int {
+	if r.min.dims == 0 {
+		return 0
+	}
+	size := 1
+	for i := 0; i < int(r.min.dims); i++ {
+		size *= int(r.max.indices[i]) - int(r.min.indices[i]) + 1
+	}
+	return size
+}
+
+// Contains reports whether c lies in the region.
+//
+// A coordinate whose dimensionality differs from the region's is never contained.
+func (r Region) Contains(c Coordinate) bool {
+	if c.dims != r.min.dims || c.dims == 0 {
+		return false
+	}
+	for i := 0; i < int(c.dims); i++ {
+		if c.indices[i] < r.min.indices[i] || c.indices[i] > r.max.indices[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns the cells common to r and o.
+//
+// The boolean is false, and the Region invalid, if they do not overlap or
+// have different dimensions.
+func (r Region) Intersect(o Region) (Region, bool) {
+	if r.min.dims != o.min.dims || r.min.dims == 0 {
+		return Region{}, false
+	}
+	result := r
+	for i := 0; i < int(r.min.dims); i++ {
+		result.min.indices[i] = max(r.min.indices[i], o.min.indices[i])
+		result.max.indices[i] = min(r.max.indices[i], o.max.indices[i])
+		if result.min.indices[i] > result.max.indices[i] {
+			return Region{}, false
+		}
+	}
+	return result, true
+}
+
+// Union returns an iterator over the cells lying in r or o, each yielded once:
+// first the cells of r, then those of o not in r, each in the order of [Region.All].
+//
+// The union of two boxes is generally not a box, hence an iterator rather
+// than a Region.
+func (r Region) Union(o Region) iter.Seq[Coordinate] {
+	return func(yield func(Coordinate) bool) {
+		for c := range r.All() {
+			if !yield(c) {
+				return
+			}
+		}
+		for c := range o.All() {
+			if !r.Contains(c) && !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over every cell of the region, in the same
+// row-major order as [Board.All].
+func (r Region) All() iter.Seq[Coordinate] {
+	return func(yield func(Coordinate) bool) {
+		if r.min.dims == 0 {
+			return
+		}
+		var lo, hi [MaxDimensions]int
+		for i := 0; i < int(r.min.dims); i++ {
+			lo[i], hi[i] = int(r.min.indices[i]), int(r.max.indices[i])+1
+		}
+		eachBox(r.min.dims, lo, hi, yield)
+	}
+}
+
+// String returns the region as two CELL coordinates separated by a colon
+// (e.g., "a1:h2"), minimum corner first. The result is accepted by [ParseRange].
+//
+// This method implements [fmt.Stringer].
+func (r Region) String() string {
+	if r.min.dims == 0 {
+		return ""
+	}
+	return r.min.String() + ":" + r.max.String()
+}
@@ -0,0 +1,302 @@
+package cell
+
+import (
+	"errors"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+// NewRegion
+// ----------------------------------------------------------------------------
+
+func TestNewRegion(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		wantMin  string
+		wantMax  string
+		wantSize int
+	}{
+		{"a1", "h2", "a1", "h2", 16},
+		{"h2", "a1", "a1", "h2", 16},
+		{"a2", "h1", "a1", "h2", 16},
+		{"e4", "e4", "e4", "e4", 1},
+		{"c", "a", "a", "c", 3},
+		{"b2B", "a1A", "a1A", "b2B", 8},
+		{"a1", "iv256", "a1", "iv256", 256 * 256},
+	}
+
+	for _, tt := range tests {
+		r := NewRegion(MustParse(tt.a), MustParse(tt.b))
+		if r.Min().String() != tt.wantMin || r.Max().String() != tt.wantMax {
+			t.Errorf("NewRegion(%s, %s) = %s:%s, want %s:%s", tt.a, tt.b, r.Min(), r.Max(), tt.wantMin, tt.wantMax)
+		}
+		if r.Size() != tt.wantSize {
+			t.Errorf("NewRegion(%s, %s).Size() = %d, want %d", tt.a, tt.b, r.Size(), tt.wantSize)
+		}
+		if r.Dims() != MustParse(tt.a).Dims() {
+			t.Errorf("NewRegion(%s, %s).Dims() = %d", tt.a, tt.b, r.Dims())
+		}
+	}
+}
+
+func TestNewRegion_Panics(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"dimension mismatch", func() { NewRegion(MustParse("a1"), MustParse("a1A")) }},
+		{"zero values", func() { NewRegion(Coordinate{}, Coordinate{}) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("NewRegion with %s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+func TestRegion_ZeroValue(t *testing.T) {
+	var r Region
+
+	if r.Size() != 0 || r.String() != "" || r.Contains(Coordinate{}) {
+		t.Error("Zero Region is not empty")
+	}
+	for c := range r.All() {
+		t.Errorf("Zero Region.All() yielded %s", c)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Contains
+// ----------------------------------------------------------------------------
+
+func TestRegion_Contains(t *testing.T) {
+	palace := NewRegion(MustParse("d1"), MustParse("f3"))
+
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"d1", true},
+		{"e2", true},
+		{"f3", true},
+		{"c2", false},
+		{"g2", false},
+		{"e4", false},
+		{"e2A", false},
+	}
+
+	for _, tt := range tests {
+		if got := palace.Contains(MustParse(tt.input)); got != tt.want {
+			t.Errorf("d1:f3.Contains(%s) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Set operations
+// ----------------------------------------------------------------------------
+
+func TestRegion_Intersect(t *testing.T) {
+	tests := []struct {
+		a, b   Region
+		want   string
+		wantOK bool
+	}{
+		{region("a1", "d4"), region("c3", "h8"), "c3:d4", true},
+		{region("a1", "h8"), region("c3", "d4"), "c3:d4", true},
+		{region("a1", "b2"), region("b2", "c3"), "b2:b2", true},
+		{region("a1", "b2"), region("c3", "d4"), "", false},
+		{region("a1", "h1"), region("a2", "h2"), "", false},
+		{region("a1", "b2"), region("a1A", "b2B"), "", false},
+		{region("a1A", "c3C"), region("b2B", "d4D"), "b2B:c3C", true},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.a.Intersect(tt.b)
+		if ok != tt.wantOK || got.String() != tt.want {
+			t.Errorf("%s.Intersect(%s) = %s, %v, want %s, %v", tt.a, tt.b, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestRegion_Union(t *testing.T) {
+	a := region("a1", "b2")
+	b := region("b2", "c2")
+
+	got := collectStrings(a.Union(b))
+	want := []string{"a1", "b1", "a2", "b2", "c2"}
+	if !equalStrings(got, want) {
+		t.Errorf("a1:b2 union b2:c2 = %v, want %v", got, want)
+	}
+
+	if n := len(collectStrings(a.Union(a))); n != a.Size() {
+		t.Errorf("a1:b2 union itself yielded %d cells, want %d", n, a.Size())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Iteration and formatting
+// ----------------------------------------------------------------------------
+
+func TestRegion_All(t *testing.T) {
+	got := collectStrings(region("b2", "c3").All())
+	want := []string{"b2", "c2", "b3", "c3"}
+	if !equalStrings(got, want) {
+		t.Errorf("b2:c3.All() = %v, want %v", got, want)
+	}
+
+	// Shogi promotion zone: the last three ranks.
+	zone := region("a7", "i9")
+	n := 0
+	for c := range zone.All() {
+		if !zone.Contains(c) {
+			t.Fatalf("a7:i9.All() yielded %s outside the region", c)
+		}
+		n++
+	}
+	if n != 27 {
+		t.Errorf("a7:i9.All() yielded %d cells, want 27", n)
+	}
+}
+
+func TestRegion_String(t *testing.T) {
+	tests := []struct {
+		region Region
+		want   string
+	}{
+		{region("h2", "a1"), "a1:h2"},
+		{region("e4", "e4"), "e4:e4"},
+		{region("a1A", "c3C"), "a1A:c3C"},
+		{region("z", "a"), "a:z"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.region.String(); got != tt.want {
+			t.Errorf("Region.String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// ParseRange
+// ----------------------------------------------------------------------------
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"a1:h8", "a1:h8"},
+		{"h8:a1", "a1:h8"},
+		{"a7:i9", "a7:i9"},
+		{"e4:e4", "e4:e4"},
+		{"a:z", "a:z"},
+		{"a1A:c3C", "a1A:c3C"},
+		{"iv256IV:a1A", "a1A:iv256IV"},
+	}
+
+	for _, tt := range tests {
+		r, err := ParseRange(tt.input)
+		if err != nil {
+			t.Errorf("ParseRange(%q) error = %v", tt.input, err)
+			continue
+		}
+		if r.String() != tt.want {
+			t.Errorf("ParseRange(%q) = %q, want %q", tt.input, r, tt.want)
+		}
+	}
+}
+
+func TestParseRange_Errors(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr error
+	}{
+		{"", ErrMissingSeparator},
+		{"a1h8", ErrMissingSeparator},
+		{"a1-h8", ErrMissingSeparator},
+		{":h8", ErrEmptyInput},
+		{"a1:", ErrEmptyInput},
+		{"a0:h8", ErrLeadingZero},
+		{"a1:h8:c3", ErrUnexpectedChar},
+		{"a1 :h8", ErrUnexpectedChar},
+		{"a1:A1", ErrInvalidStart},
+		{"a1:iw1", ErrIndexOutOfRange},
+		{"a1:h8A", ErrDimsMismatch},
+		{"a:h8", ErrDimsMismatch},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseRange(tt.input)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParseRange(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseRange_ErrorOffset(t *testing.T) {
+	_, err := ParseRange("a1:h0")
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("ParseRange(\"a1:h0\") = %v, want *ParseError", err)
+	}
+	if pe.Input != "a1:h0" || pe.Offset != 4 {
+		t.Errorf("ParseRange(\"a1:h0\") = {Input: %q, Offset: %d}, want {Input: \"a1:h0\", Offset: 4}", pe.Input, pe.Offset)
+	}
+}
+
+func TestParseRange_ErrorString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"a1:", `cell: parsing "a1:": empty input at offset 3, expected lowercase letter`},
+		{":a1", `cell: parsing ":a1": empty input`},
+		{"a1:h0", `cell: parsing "a1:h0": leading zero in number at offset 4`},
+		{"a1:zz1", `cell: parsing "a1:zz1": index exceeds 255 at offset 3`},
+		{"a1:a1A1A1A1", `cell: parsing "a1:a1A1A1A1": input exceeds 7 characters at offset 3`},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseRange(tt.input); err == nil || err.Error() != tt.want {
+			t.Errorf("ParseRange(%q) error = %v, want %s", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestParseRange_RoundTrip(t *testing.T) {
+	for _, r := range []Region{region("a1", "h2"), region("b", "iv"), region("c3C", "a1A")} {
+		got, err := ParseRange(r.String())
+		if err != nil || got != r {
+			t.Errorf("ParseRange(%q) = %s, %v, want %s", r, got, err, r)
+		}
+	}
+}
+
+func TestMustParseRange(t *testing.T) {
+	if got := MustParseRange("h2:a1"); got != region("a1", "h2") {
+		t.Errorf("MustParseRange(\"h2:a1\") = %s, want a1:h2", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustParseRange(\"a1\") did not panic")
+		}
+	}()
+	MustParseRange("a1")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers
+// ----------------------------------------------------------------------------
+
+func region(a, b string) Region {
+	return NewRegion(MustParse(a), MustParse(b))
+}
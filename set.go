@@ -0,0 +1,159 @@
+package cell
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Set is a set of coordinates on a board, stored as a bitset with one bit
+// per cell in [Board.Index] order.
+//
+// A Set uses one bit per cell, rounded up to whole 64-bit words, regardless
+// of how many coordinates it holds: 8 bytes for a chess board, 16 bytes for
+// a 9x9 or 5x5x5 board.
+//
+// The zero value is an empty set on no board; use [NewSet] to create instances.
+// A Set is not safe for concurrent modification.
+type Set struct {
+	board Board
+	words []uint64
+}
+
+// NewSet creates an empty Set sized for the cells of b.
+func NewSet(b Board) *Set {
+	return &Set{
+		board: b,
+		words: make([]uint64, (b.Size()+63)/64),
+	}
+}
+
+// Board returns the board the set was created for.
+func (s *Set) Board() Board {
+	return s.board
+}
+
+// Add inserts c into the set.
+//
+// It panics if c does not lie on the set's board.
+func (s *Set) Add(c Coordinate) {
+	i := s.board.Index(c)
+	s.words[i/64] |= 1 << (i % 64)
+}
+
+// Remove deletes c from the set. Coordinates not on the board are ignored.
+func (s *Set) Remove(c Coordinate) {
+	if !s.board.Contains(c) {
+		return
+	}
+	i := s.board.Index(c)
+	s.words[i/64] &^= 1 << (i % 64)
+}
+
+// Has reports whether c is in the set.
+func (s *Set) Has(c Coordinate) bool {
+	if !s.board.Contains(c) {
+		return false
+	}
+	i := s.board.Index(c)
+	return s.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Len returns the number of coordinates in the set.
+func (s *Set) Len() int {
+	n := 0
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Clear removes every coordinate from the set.
+func (s *Set) Clear() {
+	clear(s.words)
+}
+
+// Union adds every coordinate of o to s.
+//
+// It panics if s and o were created for boards of different extents.
+func (s *Set) Union(o *Set) {
+	s.mustMatch(o)
+	for i, w := range o.words {
+		s.words[i] |= w
+	}
+}
+
+// Intersect removes from s every coordinate not in o.
+//
+// It panics if s and o were created for boards of different extents.
+func (s *Set) Intersect(o *Set) {
+	s.mustMatch(o)
+	for i, w := range o.words {
+		s.words[i] &= w
+	}
+}
+
+// Difference removes from s every coordinate in o.
+//
+// It panics if s and o were created for boards of different extents.
+func (s *Set) Difference(o *Set) {
+	s.mustMatch(o)
+	for i, w := range o.words {
+		s.words[i] &^= w
+	}
+}
+
+// Equal reports whether s and o hold the same coordinates on boards of the
+// same extents.
+func (s *Set) Equal(o *Set) bool {
+	if !s.sameShape(o) {
+		return false
+	}
+	for i, w := range s.words {
+		if o.words[i] != w {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns an independent copy of s.
+func (s *Set) Clone() *Set {
+	return &Set{
+		board: s.board,
+		words: append([]uint64(nil), s.words...),
+	}
+}
+
+// All returns an iterator over the coordinates in the set, in the same
+// order as [Board.All].
+//
+// The set must not be modified during iteration.
+func (s *Set) All() iter.Seq[Coordinate] {
+	return func(yield func(Coordinate) bool) {
+		for i, w := range s.words {
+			for w != 0 {
+				bit := bits.TrailingZeros64(w)
+				if !yield(s.board.At(i*64 + bit)) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+// sameShape reports whether s and o index the same cells.
+func (s *Set) sameShape(o *Set) bool {
+	return sameExtents(s.board, o.board)
+}
+
+// mustMatch panics unless s and o index the same cells.
+func (s *Set) mustMatch(o *Set) {
+	if !s.sameShape(o) {
+		panic("cell: sets belong to boards of different extents")
+	}
+}
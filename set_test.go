@@ -0,0 +1,166 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// Add / Remove / Has / Len
+// ----------------------------------------------------------------------------
+
+func TestSet_Basic(t *testing.T) {
+	s := NewSet(NewBoard(8, 8))
+
+	if s.Len() != 0 {
+		t.Errorf("NewSet().Len() = %d, want 0", s.Len())
+	}
+
+	s.Add(MustParse("e4"))
+	s.Add(MustParse("a1"))
+	s.Add(MustParse("e4"))
+
+	if s.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", s.Len())
+	}
+	if !s.Has(MustParse("e4")) || !s.Has(MustParse("a1")) {
+		t.Error("Has() = false for added coordinates")
+	}
+	if s.Has(MustParse("d4")) {
+		t.Error("Has(d4) = true, want false")
+	}
+	if s.Has(MustParse("z99")) || s.Has(MustParse("e4A")) {
+		t.Error("Has() = true for coordinates outside the board")
+	}
+
+	s.Remove(MustParse("e4"))
+	s.Remove(MustParse("z99"))
+	if s.Has(MustParse("e4")) || s.Len() != 1 {
+		t.Errorf("after Remove(e4): Has(e4) = %v, Len() = %d", s.Has(MustParse("e4")), s.Len())
+	}
+
+	s.Clear()
+	if s.Len() != 0 {
+		t.Errorf("after Clear(): Len() = %d, want 0", s.Len())
+	}
+}
+
+func TestSet_LargeBoard(t *testing.T) {
+	b := NewBoard(5, 5, 5)
+	s := NewSet(b)
+
+	for c := range b.All() {
+		s.Add(c)
+	}
+	if s.Len() != 125 {
+		t.Errorf("Len() = %d, want 125", s.Len())
+	}
+	for c := range b.All() {
+		if !s.Has(c) {
+			t.Fatalf("Has(%s) = false", c)
+		}
+	}
+}
+
+func TestSet_Add_PanicsOutsideBoard(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Add(i1) on 8x8 set did not panic")
+		}
+	}()
+	NewSet(NewBoard(8, 8)).Add(MustParse("i1"))
+}
+
+// ----------------------------------------------------------------------------
+// Set operations
+// ----------------------------------------------------------------------------
+
+func TestSet_Operations(t *testing.T) {
+	b := NewBoard(9, 9)
+	a := newSet(b, "a1", "b2", "c3", "i9")
+	c := newSet(b, "b2", "c3", "d4")
+
+	union := a.Clone()
+	union.Union(c)
+	if want := newSet(b, "a1", "b2", "c3", "d4", "i9"); !union.Equal(want) {
+		t.Errorf("Union = %v, want %v", collectStrings(union.All()), collectStrings(want.All()))
+	}
+
+	inter := a.Clone()
+	inter.Intersect(c)
+	if want := newSet(b, "b2", "c3"); !inter.Equal(want) {
+		t.Errorf("Intersect = %v, want %v", collectStrings(inter.All()), collectStrings(want.All()))
+	}
+
+	diff := a.Clone()
+	diff.Difference(c)
+	if want := newSet(b, "a1", "i9"); !diff.Equal(want) {
+		t.Errorf("Difference = %v, want %v", collectStrings(diff.All()), collectStrings(want.All()))
+	}
+
+	if a.Len() != 4 {
+		t.Error("operations on a clone modified the original")
+	}
+}
+
+func TestSet_Operations_PanicOnDifferentBoards(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Union of sets on different boards did not panic")
+		}
+	}()
+	NewSet(NewBoard(8, 8)).Union(NewSet(NewBoard(9, 9)))
+}
+
+func TestSet_Equal(t *testing.T) {
+	b := NewBoard(8, 8)
+
+	if !newSet(b, "e4").Equal(newSet(b, "e4")) {
+		t.Error("equal sets are not Equal")
+	}
+	if newSet(b, "e4").Equal(newSet(b, "e5")) {
+		t.Error("different sets are Equal")
+	}
+	if NewSet(b).Equal(NewSet(NewBoard(4, 16))) {
+		t.Error("empty sets on different boards are Equal")
+	}
+	if !NewSet(b).Equal(NewSet(b.WithWrap(0))) {
+		t.Error("empty sets on boards differing only by wraparound are not Equal")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Iteration
+// ----------------------------------------------------------------------------
+
+func TestSet_All(t *testing.T) {
+	s := newSet(NewBoard(8, 8), "h8", "a1", "e4", "b1")
+
+	got := collectStrings(s.All())
+	want := []string{"a1", "b1", "e4", "h8"}
+	if !equalStrings(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+}
+
+func TestSet_All_EarlyStop(t *testing.T) {
+	s := newSet(NewBoard(8, 8), "a1", "b1", "c1")
+
+	n := 0
+	for range s.All() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("iteration ran %d times after break, want 1", n)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers
+// ----------------------------------------------------------------------------
+
+func newSet(b Board, inputs ...string) *Set {
+	s := NewSet(b)
+	for _, in := range inputs {
+		s.Add(MustParse(in))
+	}
+	return s
+}
@@ -0,0 +1,103 @@
+package cell
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Scan reads a coordinate from a database column.
+//
+// TEXT columns ([string] or []byte) must hold a CELL string and are checked
+// as by [Validate]; integer columns must hold a key produced by
+// [Coordinate.Pack] and are decoded as by [Unpack]. Integers delivered as
+// decimal text, as text-protocol drivers such as MySQL's do, are decoded
+// as keys too. Use [NullCoordinate] for nullable columns.
+//
+// This method implements [database/sql.Scanner]. On error, c is left unchanged.
+func (c *Coordinate) Scan(src any) error {
+	var (
+		parsed Coordinate
+		err    error
+	)
+	switch v := src.(type) {
+	case string:
+		parsed, err = scanText(v)
+	case []byte:
+		parsed, err = scanText(string(v))
+	case int64:
+		if v < 0 || v > 0xFFFFFFFF {
+			return ErrInvalidEncoding
+		}
+		parsed, err = Unpack(uint32(v))
+	default:
+		return fmt.Errorf("cell: cannot scan %T into Coordinate", src)
+	}
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// scanText decodes a TEXT column value: a packed key if s is all digits,
+// which a CELL string never is, and a CELL string otherwise.
+func scanText(s string) (Coordinate, error) {
+	if s == "" || !isDigit(s[0]) {
+		return Parse(s)
+	}
+	for i := 1; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return Parse(s)
+		}
+	}
+	key, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return Coordinate{}, ErrInvalidEncoding
+	}
+	return Unpack(uint32(key))
+}
+
+// Value returns the CELL string of c for storage in a TEXT column.
+//
+// To store a coordinate in an integer column, pass int64(c.Pack()) instead.
+// This method implements [database/sql/driver.Valuer].
+// It returns [ErrInvalidCoordinate] for the zero value.
+func (c Coordinate) Value() (driver.Value, error) {
+	if c.dims == 0 {
+		return nil, ErrInvalidCoordinate
+	}
+	return c.String(), nil
+}
+
+// NullCoordinate represents a Coordinate that may be NULL in a database.
+//
+// It implements [database/sql.Scanner] and [database/sql/driver.Valuer]
+// in the same way as [database/sql.NullString].
+type NullCoordinate struct {
+	Coordinate Coordinate
+	Valid      bool // Valid is true if Coordinate is not NULL
+}
+
+// Scan reads a nullable coordinate from a database column.
+//
+// NULL sets Valid to false; any other value is scanned as by [Coordinate.Scan].
+func (n *NullCoordinate) Scan(src any) error {
+	if src == nil {
+		n.Coordinate, n.Valid = Coordinate{}, false
+		return nil
+	}
+	if err := n.Coordinate.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value returns nil if n is not valid, or the CELL string of its Coordinate.
+func (n NullCoordinate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Coordinate.Value()
+}
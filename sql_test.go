@@ -0,0 +1,110 @@
+package cell
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+// Interface compliance.
+var (
+	_ sql.Scanner   = (*Coordinate)(nil)
+	_ driver.Valuer = Coordinate{}
+	_ sql.Scanner   = (*NullCoordinate)(nil)
+	_ driver.Valuer = NullCoordinate{}
+)
+
+// ----------------------------------------------------------------------------
+// Coordinate
+// ----------------------------------------------------------------------------
+
+func TestCoordinate_Scan(t *testing.T) {
+	tests := []struct {
+		src  any
+		want string
+	}{
+		{"e4", "e4"},
+		{[]byte("a1A"), "a1A"},
+		{int64(0x02000304), "e4"},
+		{int64(MustParse("iv256IV").Pack()), "iv256IV"},
+		{[]byte("33555204"), "e4"},
+		{"33555204", "e4"},
+	}
+
+	for _, tt := range tests {
+		var c Coordinate
+		if err := c.Scan(tt.src); err != nil || c.String() != tt.want {
+			t.Errorf("Scan(%v) = %s, %v, want %s", tt.src, c, err, tt.want)
+		}
+	}
+}
+
+func TestCoordinate_Scan_Errors(t *testing.T) {
+	tests := []struct {
+		src     any
+		wantErr error
+	}{
+		{"e0", ErrLeadingZero},
+		{"", ErrEmptyInput},
+		{[]byte("E4"), ErrInvalidStart},
+		{int64(0), ErrInvalidEncoding},
+		{int64(-1), ErrInvalidEncoding},
+		{int64(1 << 32), ErrInvalidEncoding},
+		{[]byte("0"), ErrInvalidEncoding},
+		{[]byte("4294967296"), ErrInvalidEncoding},
+		{"99999999999999999999", ErrInvalidEncoding},
+		{nil, nil},
+		{3.5, nil},
+	}
+
+	for _, tt := range tests {
+		c := MustParse("e4")
+		err := c.Scan(tt.src)
+		if err == nil {
+			t.Errorf("Scan(%v) error = nil", tt.src)
+		} else if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+			t.Errorf("Scan(%v) error = %v, want %v", tt.src, err, tt.wantErr)
+		}
+		if c != MustParse("e4") {
+			t.Errorf("failed Scan(%v) modified the coordinate to %s", tt.src, c)
+		}
+	}
+}
+
+func TestCoordinate_Value(t *testing.T) {
+	v, err := MustParse("c3C").Value()
+	if err != nil || v != "c3C" {
+		t.Errorf("Value() = %v, %v, want %q", v, err, "c3C")
+	}
+
+	if _, err := (Coordinate{}).Value(); !errors.Is(err, ErrInvalidCoordinate) {
+		t.Errorf("zero Coordinate Value() error = %v, want ErrInvalidCoordinate", err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// NullCoordinate
+// ----------------------------------------------------------------------------
+
+func TestNullCoordinate(t *testing.T) {
+	var n NullCoordinate
+
+	if err := n.Scan("e4"); err != nil || !n.Valid || n.Coordinate.String() != "e4" {
+		t.Errorf("Scan(e4) = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != "e4" {
+		t.Errorf("Value() = %v, %v, want %q", v, err, "e4")
+	}
+
+	if err := n.Scan(nil); err != nil || n.Valid || n.Coordinate != (Coordinate{}) {
+		t.Errorf("Scan(nil) = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Value() of NULL = %v, %v, want nil", v, err)
+	}
+
+	if err := n.Scan("a0"); !errors.Is(err, ErrLeadingZero) || n.Valid {
+		t.Errorf("Scan(a0) = %+v, %v, want ErrLeadingZero", n, err)
+	}
+}
@@ -0,0 +1,107 @@
+package cell
+
+import (
+	"math/bits"
+	"slices"
+	"strings"
+)
+
+// Suggest proposes the coordinates most likely intended by an invalid CELL
+// string, for use in error messages on human input.
+//
+// It repairs common mistakes: surrounding whitespace ("e4 " → e4), an
+// uppercase first dimension ("E4" → e4), a rank written before the file
+// ("4e" → e4), leading zeros ("e04" → e4) and a zero rank ("a0" → a1).
+// Candidates needing fewer repairs come first.
+//
+// Suggest returns nil if s is already valid or no repair yields a valid
+// coordinate.
+func Suggest(s string) []Coordinate {
+	if validate(s) == nil {
+		return nil
+	}
+
+	var out []Coordinate
+	for n := 1; n <= len(repairs); n++ {
+		for mask := 1; mask < 1<<len(repairs); mask++ {
+			if bits.OnesCount(uint(mask)) != n {
+				continue
+			}
+			t := s
+			for i, repair := range repairs {
+				if mask&(1<<i) != 0 {
+					t = repair(t)
+				}
+			}
+			if c, err := Parse(t); err == nil && !slices.Contains(out, c) {
+				out = append(out, c)
+			}
+		}
+	}
+	return out
+}
+
+// repairs lists the corrections tried by Suggest, in the order they are
+// applied when combined.
+var repairs = [...]func(string) string{
+	strings.TrimSpace,
+	swapLeadingDigits,
+	foldFirstDim,
+	trimZeros,
+}
+
+// swapLeadingDigits moves a leading run of digits after the run of letters
+// that follows it: "4e" becomes "e4".
+func swapLeadingDigits(s string) string {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	j := i
+	for j < len(s) && (isLower(s[j]) || isUpper(s[j])) {
+		j++
+	}
+	if i == 0 || j == i {
+		return s
+	}
+	return s[i:j] + s[:i] + s[j:]
+}
+
+// foldFirstDim lowercases the leading run of letters: "E4" becomes "e4".
+func foldFirstDim(s string) string {
+	i := 0
+	for i < len(s) && (isLower(s[i]) || isUpper(s[i])) {
+		i++
+	}
+	return strings.ToLower(s[:i]) + s[i:]
+}
+
+// trimZeros strips leading zeros from each run of digits, turning a run of
+// zeros into "1": "e04" becomes "e4" and "a0" becomes "a1".
+func trimZeros(s string) string {
+	return mapDigitRuns(s, func(run string) string {
+		if run = strings.TrimLeft(run, "0"); run == "" {
+			return "1"
+		}
+		return run
+	})
+}
+
+// mapDigitRuns replaces each maximal run of digits in s with f(run).
+func mapDigitRuns(s string, f func(run string) string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if !isDigit(s[i]) {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(s) && isDigit(s[j]) {
+			j++
+		}
+		b.WriteString(f(s[i:j]))
+		i = j
+	}
+	return b.String()
+}
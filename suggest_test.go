@@ -0,0 +1,54 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// Suggest
+// ----------------------------------------------------------------------------
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"E4", []string{"e4"}},
+		{"e04", []string{"e4"}},
+		{"4e", []string{"e4"}},
+		{"a0", []string{"a1"}},
+		{"e4 ", []string{"e4"}},
+		{"\te4\n", []string{"e4"}},
+		{"A1A", []string{"a1A"}},
+		{"4E", []string{"e4"}},
+		{" E04 ", []string{"e4"}},
+		{"0e", []string{"e1"}},
+		{"a00B", []string{"a1B"}},
+		{"c010C", []string{"c10C"}},
+	}
+
+	for _, tt := range tests {
+		got := Suggest(tt.input)
+		var strs []string
+		for _, c := range got {
+			strs = append(strs, c.String())
+		}
+		if !equalStrings(strs, tt.want) {
+			t.Errorf("Suggest(%q) = %v, want %v", tt.input, strs, tt.want)
+		}
+	}
+}
+
+func TestSuggest_ValidInput(t *testing.T) {
+	for _, s := range []string{"e4", "a1A", "iv256IV"} {
+		if got := Suggest(s); got != nil {
+			t.Errorf("Suggest(%q) = %v, want nil", s, got)
+		}
+	}
+}
+
+func TestSuggest_NoRepair(t *testing.T) {
+	for _, s := range []string{"", "!", "e4?", "iw", "a257", "a1A1A1A1", "e 4"} {
+		if got := Suggest(s); got != nil {
+			t.Errorf("Suggest(%q) = %v, want nil", s, got)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package cell
+
+// Transform is a symmetry of an axis-aligned board: a permutation of the
+// dimensions combined with reflections along some of them. In 2D these are
+// the 8 rotations and mirrors of a square; in 3D the 48 symmetries of a cube.
+//
+// Transforms are applied to coordinates with [Board.Apply]. The zero value
+// is not valid; use [Identity], [Transforms] or [Board.Symmetries].
+type Transform struct {
+	perm [MaxDimensions]uint8 // output dimension i reads input dimension perm[i]
+	flip uint8                // bit i set: output dimension i is mirrored
+	dims uint8
+}
+
+// Identity returns the transform leaving every coordinate unchanged.
+//
+// It panics if dims is not 1, 2, or 3.
+func Identity(dims int) Transform {
+	if dims < 1 || dims > MaxDimensions {
+		panic("cell: dimensions must be between 1 and 3")
+	}
+	return Transform{perm: [MaxDimensions]uint8{0, 1, 2}, dims: uint8(dims)}
+}
+
+// Transforms returns every axis permutation and reflection for the given
+// dimensionality: 2 in 1D, 8 in 2D and 48 in 3D. The identity comes first.
+//
+// It panics if dims is not 1, 2, or 3.
+func Transforms(dims int) []Transform {
+	if dims < 1 || dims > MaxDimensions {
+		panic("cell: dimensions must be between 1 and 3")
+	}
+	var result []Transform
+	for _, perm := range permutations[dims] {
+		for flip := 0; flip < 1<<dims; flip++ {
+			t := Transform{flip: uint8(flip), dims: uint8(dims)}
+			for i := 0; i < MaxDimensions; i++ {
+				t.perm[i] = uint8(i)
+			}
+			for i := 0; i < dims; i++ {
+				t.perm[i] = uint8(perm[i])
+			}
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// Named 2D transforms, with the first dimension running left to right and
+// the second bottom to top. They are the transforms applied by the
+// [Board] methods of the same names.
+var (
+	// Rotate90 is a quarter turn clockwise.
+	Rotate90 = Transform{perm: [MaxDimensions]uint8{1, 0, 2}, flip: 0b10, dims: 2}
+
+	// Rotate180 is a half turn.
+	Rotate180 = Transform{perm: [MaxDimensions]uint8{0, 1, 2}, flip: 0b11, dims: 2}
+
+	// Rotate270 is a quarter turn counterclockwise.
+	Rotate270 = Transform{perm: [MaxDimensions]uint8{1, 0, 2}, flip: 0b01, dims: 2}
+
+	// MirrorFile mirrors the first dimension (files).
+	MirrorFile = Transform{perm: [MaxDimensions]uint8{0, 1, 2}, flip: 0b01, dims: 2}
+
+	// MirrorRank mirrors the second dimension (ranks).
+	MirrorRank = Transform{perm: [MaxDimensions]uint8{0, 1, 2}, flip: 0b10, dims: 2}
+
+	// Transpose swaps the two dimensions (a1-h8 diagonal reflection).
+	Transpose = Transform{perm: [MaxDimensions]uint8{1, 0, 2}, dims: 2}
+)
+
+// Dims returns the number of dimensions (1, 2, or 3).
+func (t Transform) Dims() int {
+	return int(t.dims)
+}
+
+// IsIdentity reports whether t leaves every coordinate unchanged.
+func (t Transform) IsIdentity() bool {
+	return t.dims != 0 && t == Identity(int(t.dims))
+}
+
+// Then returns the transform applying t first and u second.
+//
+// It panics if t and u have different dimensions.
+func (t Transform) Then(u Transform) Transform {
+	mustMatchDims(t.dims, u.dims)
+	r := t
+	r.flip = 0
+	for i := 0; i < int(t.dims); i++ {
+		j := u.perm[i]
+		r.perm[i] = t.perm[j]
+		r.flip |= (u.flip>>i ^ t.flip>>j) & 1 << i
+	}
+	return r
+}
+
+// Inverse returns the transform undoing t.
+func (t Transform) Inverse() Transform {
+	r := t
+	r.flip = 0
+	for i := 0; i < int(t.dims); i++ {
+		j := t.perm[i]
+		r.perm[j] = uint8(i)
+		r.flip |= (t.flip >> i & 1) << j
+	}
+	return r
+}
+
+// String returns the image of a generic point (x, y, z) in parentheses,
+// with a minus sign on mirrored dimensions (e.g., "(y,-x)" for [Rotate90],
+// "(-x,y)" for [MirrorFile], "(x,y,z)" for the 3D identity).
+//
+// This method implements [fmt.Stringer].
+func (t Transform) String() string {
+	buf := []byte{'('}
+	for i := 0; i < int(t.dims); i++ {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if t.flip>>i&1 != 0 {
+			buf = append(buf, '-')
+		}
+		buf = append(buf, "xyz"[t.perm[i]])
+	}
+	return string(append(buf, ')'))
+}
+
+// ----------------------------------------------------------------------------
+// Board transformations
+// ----------------------------------------------------------------------------
+
+// Apply returns the image of c under t.
+//
+// Reflections mirror indices within the board's extents, so the result lies
+// on [Board.Image] of t, which differs from b only when t swaps dimensions
+// of unequal extent.
+//
+// It panics if c does not lie on the board or if t has a different dimensionality.
+func (b Board) Apply(t Transform, c Coordinate) Coordinate {
+	mustMatchDims(b.dims, t.dims)
+	if !b.Contains(c) {
+		panic("cell: coordinate outside board")
+	}
+	r := Coordinate{dims: c.dims}
+	for i := 0; i < int(t.dims); i++ {
+		j := t.perm[i]
+		v := c.indices[j]
+		if t.flip>>i&1 != 0 {
+			v = uint8(b.extents[j]-1) - v
+		}
+		r.indices[i] = v
+	}
+	return r
+}
+
+// Image returns the board that t maps b onto: b with its extents and
+// wraparound dimensions permuted.
+//
+// It panics if t has a different dimensionality.
+func (b Board) Image(t Transform) Board {
+	mustMatchDims(b.dims, t.dims)
+	r := b
+	r.wrap = 0
+	for i := 0; i < int(t.dims); i++ {
+		j := t.perm[i]
+		r.extents[i] = b.extents[j]
+		r.wrap |= (b.wrap >> j & 1) << i
+	}
+	return r
+}
+
+// Symmetries returns the transforms mapping b onto itself, identity first.
+//
+// A square board has 8, a cube 48; a rectangular board such as 9x10 keeps
+// only the 4 that do not swap its unequal dimensions. Likewise, dimensions
+// are only swapped when they agree on wraparound.
+func (b Board) Symmetries() []Transform {
+	var result []Transform
+	for _, t := range Transforms(int(b.dims)) {
+		if b.Image(t) == b {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// Rotate90 returns c rotated a quarter turn clockwise, with the first
+// dimension running left to right and the second bottom to top.
+// On a chess board, a1 maps to a8 and a8 to h8.
+//
+// On a non-square board the result lies on the board with swapped extents.
+// It panics unless b is 2-dimensional and contains c.
+func (b Board) Rotate90(c Coordinate) Coordinate {
+	return b.apply2D(Rotate90, c)
+}
+
+// Rotate180 returns c rotated a half turn, as seen by the opposing player.
+// On a chess board, a1 maps to h8 and e2 to d7.
+//
+// It panics unless b is 2-dimensional and contains c.
+func (b Board) Rotate180(c Coordinate) Coordinate {
+	return b.apply2D(Rotate180, c)
+}
+
+// Rotate270 returns c rotated a quarter turn counterclockwise.
+// On a chess board, a1 maps to h1 and h1 to h8.
+//
+// On a non-square board the result lies on the board with swapped extents.
+// It panics unless b is 2-dimensional and contains c.
+func (b Board) Rotate270(c Coordinate) Coordinate {
+	return b.apply2D(Rotate270, c)
+}
+
+// MirrorFile returns c with its file mirrored (a <-> h on a chess board),
+// keeping its rank.
+//
+// It panics unless b is 2-dimensional and contains c.
+func (b Board) MirrorFile(c Coordinate) Coordinate {
+	return b.apply2D(MirrorFile, c)
+}
+
+// MirrorRank returns c with its rank mirrored (1 <-> 8 on a chess board),
+// keeping its file.
+//
+// It panics unless b is 2-dimensional and contains c.
+func (b Board) MirrorRank(c Coordinate) Coordinate {
+	return b.apply2D(MirrorRank, c)
+}
+
+// Transpose returns c with its file and rank indices swapped
+// (a1-h8 diagonal reflection; b1 maps to a2).
+//
+// On a non-square board the result lies on the board with swapped extents.
+// It panics unless b is 2-dimensional and contains c.
+func (b Board) Transpose(c Coordinate) Coordinate {
+	return b.apply2D(Transpose, c)
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+// apply2D applies a named 2D transform, panicking on other board dimensions.
+func (b Board) apply2D(t Transform, c Coordinate) Coordinate {
+	if b.dims != 2 {
+		panic("cell: transform requires a 2-dimensional board")
+	}
+	return b.Apply(t, c)
+}
@@ -0,0 +1,294 @@
+package cell
+
+import (
+	"slices"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+// Named 2D transforms
+// ----------------------------------------------------------------------------
+
+func TestBoard_NamedTransforms(t *testing.T) {
+	chess := NewBoard(8, 8)
+
+	tests := []struct {
+		name string
+		fn   func(Coordinate) Coordinate
+		in   string
+		want string
+	}{
+		{"Rotate90", chess.Rotate90, "a1", "a8"},
+		{"Rotate90", chess.Rotate90, "a8", "h8"},
+		{"Rotate90", chess.Rotate90, "e4", "d4"},
+		{"Rotate180", chess.Rotate180, "a1", "h8"},
+		{"Rotate180", chess.Rotate180, "e2", "d7"},
+		{"Rotate270", chess.Rotate270, "a1", "h1"},
+		{"Rotate270", chess.Rotate270, "h1", "h8"},
+		{"MirrorFile", chess.MirrorFile, "a1", "h1"},
+		{"MirrorFile", chess.MirrorFile, "e4", "d4"},
+		{"MirrorRank", chess.MirrorRank, "a1", "a8"},
+		{"MirrorRank", chess.MirrorRank, "e4", "e5"},
+		{"Transpose", chess.Transpose, "b1", "a2"},
+		{"Transpose", chess.Transpose, "h8", "h8"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn(MustParse(tt.in)); got.String() != tt.want {
+			t.Errorf("%s(%s) = %s, want %s", tt.name, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBoard_Rotate_Composition(t *testing.T) {
+	b := NewBoard(5, 5)
+
+	for c := range b.All() {
+		if got := b.Rotate90(b.Rotate90(c)); got != b.Rotate180(c) {
+			t.Errorf("Rotate90 twice of %s = %s, want %s", c, got, b.Rotate180(c))
+		}
+		if got := b.Rotate90(b.Rotate270(c)); got != c {
+			t.Errorf("Rotate90(Rotate270(%s)) = %s", c, got)
+		}
+		if got := b.MirrorFile(b.MirrorRank(c)); got != b.Rotate180(c) {
+			t.Errorf("MirrorFile(MirrorRank(%s)) = %s, want %s", c, got, b.Rotate180(c))
+		}
+	}
+}
+
+func TestBoard_Rotate_NonSquare(t *testing.T) {
+	xiangqi := NewBoard(9, 10)
+	rotated := NewBoard(10, 9)
+
+	for c := range xiangqi.All() {
+		r := xiangqi.Rotate90(c)
+		if !rotated.Contains(r) {
+			t.Fatalf("Rotate90(%s) = %s, outside %s", c, r, rotated)
+		}
+		if back := rotated.Rotate270(r); back != c {
+			t.Fatalf("Rotate270(Rotate90(%s)) = %s", c, back)
+		}
+	}
+	if got := xiangqi.Rotate180(MustParse("a1")); got.String() != "i10" {
+		t.Errorf("Rotate180(a1) on 9x10 = %s, want i10", got)
+	}
+}
+
+func TestBoard_NamedTransforms_Panics(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"Rotate90 on 3D board", func() { NewBoard(4, 4, 4).Rotate90(MustParse("a1A")) }},
+		{"MirrorFile outside board", func() { NewBoard(8, 8).MirrorFile(MustParse("i1")) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Transform group
+// ----------------------------------------------------------------------------
+
+func TestTransforms_Counts(t *testing.T) {
+	tests := []struct {
+		dims int
+		want int
+	}{
+		{1, 2},
+		{2, 8},
+		{3, 48},
+	}
+
+	for _, tt := range tests {
+		ts := Transforms(tt.dims)
+		if len(ts) != tt.want {
+			t.Errorf("len(Transforms(%d)) = %d, want %d", tt.dims, len(ts), tt.want)
+		}
+		if !ts[0].IsIdentity() {
+			t.Errorf("Transforms(%d)[0] is not the identity", tt.dims)
+		}
+	}
+}
+
+func TestTransforms_Distinct(t *testing.T) {
+	cube := NewBoard(3, 3, 3)
+	probe := []Coordinate{MustParse("a1A"), MustParse("b1A"), MustParse("a2A")}
+	seen := make(map[[3]Coordinate]bool)
+
+	for _, tr := range Transforms(3) {
+		var key [3]Coordinate
+		for i, c := range probe {
+			key[i] = cube.Apply(tr, c)
+		}
+		if seen[key] {
+			t.Fatalf("Transforms(3) contains duplicate images %v", key)
+		}
+		seen[key] = true
+	}
+}
+
+func TestTransform_ThenAndInverse(t *testing.T) {
+	for _, b := range []Board{NewBoard(3), NewBoard(3, 4), NewBoard(3, 4, 5)} {
+		dims := b.Dims()
+		for _, tr := range Transforms(dims) {
+			inv := tr.Inverse()
+			if !tr.Then(inv).IsIdentity() || !inv.Then(tr).IsIdentity() {
+				t.Fatalf("Transform composed with its inverse is not the identity")
+			}
+			image := b.Image(tr)
+			for _, u := range Transforms(dims) {
+				both := tr.Then(u)
+				for c := range b.All() {
+					want := image.Apply(u, b.Apply(tr, c))
+					if got := b.Apply(both, c); got != want {
+						t.Fatalf("Then: got %s, want %s for %s", got, want, c)
+					}
+				}
+			}
+			for c := range b.All() {
+				if back := image.Apply(inv, b.Apply(tr, c)); back != c {
+					t.Fatalf("Inverse did not restore %s, got %s", c, back)
+				}
+			}
+		}
+	}
+}
+
+func TestTransform_Named(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Transform
+		want Transform
+	}{
+		{"Rotate90.Then(Rotate90)", Rotate90.Then(Rotate90), Rotate180},
+		{"Rotate90.Then(Rotate180)", Rotate90.Then(Rotate180), Rotate270},
+		{"Rotate90.Inverse()", Rotate90.Inverse(), Rotate270},
+		{"MirrorFile.Then(MirrorRank)", MirrorFile.Then(MirrorRank), Rotate180},
+		{"Transpose.Then(Transpose)", Transpose.Then(Transpose), Identity(2)},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+
+	chess := NewBoard(8, 8)
+	named := []struct {
+		tr Transform
+		fn func(Coordinate) Coordinate
+	}{
+		{Rotate90, chess.Rotate90},
+		{Rotate180, chess.Rotate180},
+		{Rotate270, chess.Rotate270},
+		{MirrorFile, chess.MirrorFile},
+		{MirrorRank, chess.MirrorRank},
+		{Transpose, chess.Transpose},
+	}
+	for _, n := range named {
+		if !slices.Contains(chess.Symmetries(), n.tr) {
+			t.Errorf("%s is not a symmetry of the chess board", n.tr)
+		}
+		for c := range chess.All() {
+			if got, want := chess.Apply(n.tr, c), n.fn(c); got != want {
+				t.Fatalf("Apply(%s, %s) = %s, want %s", n.tr, c, got, want)
+			}
+		}
+	}
+}
+
+func TestTransform_String(t *testing.T) {
+	tests := []struct {
+		tr   Transform
+		want string
+	}{
+		{Identity(1), "(x)"},
+		{Identity(2), "(x,y)"},
+		{Identity(3), "(x,y,z)"},
+		{Rotate90, "(y,-x)"},
+		{Rotate180, "(-x,-y)"},
+		{Rotate270, "(-y,x)"},
+		{MirrorFile, "(-x,y)"},
+		{MirrorRank, "(x,-y)"},
+		{Transpose, "(y,x)"},
+		{Transform{}, "()"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.tr.String(); got != tt.want {
+			t.Errorf("Transform.String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestIdentity_PanicsOnInvalidDims(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Identity(4) did not panic")
+		}
+	}()
+	Identity(4)
+}
+
+// ----------------------------------------------------------------------------
+// Board symmetries
+// ----------------------------------------------------------------------------
+
+func TestBoard_Symmetries(t *testing.T) {
+	tests := []struct {
+		board Board
+		want  int
+	}{
+		{NewBoard(8), 2},
+		{NewBoard(8, 8), 8},
+		{NewBoard(9, 10), 4},
+		{NewBoard(5, 5, 5), 48},
+		{NewBoard(4, 4, 8), 16},
+		{NewBoard(2, 3, 4), 8},
+	}
+
+	for _, tt := range tests {
+		syms := tt.board.Symmetries()
+		if len(syms) != tt.want {
+			t.Errorf("len(NewBoard(%s).Symmetries()) = %d, want %d", tt.board, len(syms), tt.want)
+		}
+		for _, s := range syms {
+			for c := range tt.board.All() {
+				if !tt.board.Contains(tt.board.Apply(s, c)) {
+					t.Fatalf("symmetry of %s maps %s off the board", tt.board, c)
+				}
+			}
+		}
+	}
+}
+
+func TestBoard_Apply_Panics(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"dimension mismatch", func() { NewBoard(8, 8).Apply(Identity(3), MustParse("a1")) }},
+		{"outside board", func() { NewBoard(8, 8).Apply(Identity(2), MustParse("a9")) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Apply with %s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
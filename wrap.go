@@ -0,0 +1,102 @@
+package cell
+
+// WithWrap returns a copy of b in which the given dimensions (0-indexed)
+// wrap around: stepping past the last index continues at index 0 and
+// vice versa. Other dimensions keep their current setting.
+//
+// A cylinder chess board wraps its files, NewBoard(8, 8).WithWrap(0);
+// a toroidal Go board wraps both, NewBoard(19, 19).WithWrap(0, 1).
+// Wraparound affects [Board.Add] and everything built on it (neighbours,
+// rays, leapers) as well as [Board.Sub] and the board distance methods.
+//
+// It panics if a dimension is out of range (i >= Dims()).
+func (b Board) WithWrap(dims ...int) Board {
+	for _, i := range dims {
+		if i < 0 || i >= int(b.dims) {
+			panic("cell: index out of range")
+		}
+		b.wrap |= 1 << i
+	}
+	return b
+}
+
+// Wraps reports whether dimension i (0-indexed) wraps around.
+//
+// It panics if i is out of range (i >= Dims()).
+func (b Board) Wraps(i int) bool {
+	if i < 0 || i >= int(b.dims) {
+		panic("cell: index out of range")
+	}
+	return b.wrap>>i&1 != 0
+}
+
+// Add returns c displaced by d on the board.
+//
+// Indices along wrapping dimensions are reduced modulo the extent. The
+// boolean is false if c is not on the board, if d has a different
+// dimensionality, or if the result leaves a non-wrapping dimension.
+// On a board without wraparound this is [Coordinate.Add] restricted to the board.
+func (b Board) Add(c Coordinate, d Delta) (Coordinate, bool) {
+	if !b.Contains(c) || d.dims != c.dims {
+		return Coordinate{}, false
+	}
+	for i := 0; i < int(c.dims); i++ {
+		e := int(b.extents[i])
+		v := int(c.indices[i]) + d.offsets[i]
+		if b.wrap>>i&1 != 0 {
+			v = ((v % e) + e) % e
+		} else if v < 0 || v >= e {
+			return Coordinate{}, false
+		}
+		c.indices[i] = uint8(v)
+	}
+	return c, true
+}
+
+// Sub returns the shortest displacement from o to c on the board, so that
+// b.Add(o, b.Sub(c, o)) == c.
+//
+// Along wrapping dimensions the offset is the one of smallest magnitude,
+// preferring the positive offset on a tie. Elsewhere it is the plain
+// difference, as for [Coordinate.Sub].
+//
+// It panics if c or o does not lie on the board.
+func (b Board) Sub(c, o Coordinate) Delta {
+	if !b.Contains(c) || !b.Contains(o) {
+		panic("cell: coordinate outside board")
+	}
+	d := c.Sub(o)
+	for i := 0; i < int(d.dims); i++ {
+		if b.wrap>>i&1 == 0 {
+			continue
+		}
+		e := int(b.extents[i])
+		if d.offsets[i] > e/2 {
+			d.offsets[i] -= e
+		} else if d.offsets[i] < -((e - 1) / 2) {
+			d.offsets[i] += e
+		}
+	}
+	return d
+}
+
+// Manhattan is like [Manhattan] but measures across wrapped edges.
+//
+// It panics if x or y does not lie on the board.
+func (b Board) Manhattan(x, y Coordinate) int {
+	return b.Sub(x, y).manhattan()
+}
+
+// Chebyshev is like [Chebyshev] but measures across wrapped edges.
+//
+// It panics if x or y does not lie on the board.
+func (b Board) Chebyshev(x, y Coordinate) int {
+	return b.Sub(x, y).chebyshev()
+}
+
+// EuclideanSquared is like [EuclideanSquared] but measures across wrapped edges.
+//
+// It panics if x or y does not lie on the board.
+func (b Board) EuclideanSquared(x, y Coordinate) int {
+	return b.Sub(x, y).euclideanSquared()
+}
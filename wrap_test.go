@@ -0,0 +1,238 @@
+package cell
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+// WithWrap / Wraps
+// ----------------------------------------------------------------------------
+
+func TestBoard_WithWrap(t *testing.T) {
+	plain := NewBoard(8, 8)
+	cylinder := plain.WithWrap(0)
+	torus := plain.WithWrap(0, 1)
+
+	if plain.Wraps(0) || plain.Wraps(1) {
+		t.Error("NewBoard(8, 8) wraps, want no wraparound")
+	}
+	if !cylinder.Wraps(0) || cylinder.Wraps(1) {
+		t.Error("WithWrap(0) did not wrap only the first dimension")
+	}
+	if !torus.Wraps(0) || !torus.Wraps(1) {
+		t.Error("WithWrap(0, 1) did not wrap both dimensions")
+	}
+	if cylinder == plain {
+		t.Error("wrapping and non-wrapping boards compare equal")
+	}
+	if cylinder.Size() != 64 || !cylinder.Contains(MustParse("h8")) {
+		t.Error("WithWrap changed the board's cells")
+	}
+}
+
+func TestBoard_WithWrap_Panics(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"WithWrap(2) on 2D", func() { NewBoard(8, 8).WithWrap(2) }},
+		{"WithWrap(-1)", func() { NewBoard(8, 8).WithWrap(-1) }},
+		{"Wraps(1) on 1D", func() { NewBoard(8).Wraps(1) }},
+	}
+
+	for _, tt := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Add / Sub
+// ----------------------------------------------------------------------------
+
+func TestBoard_Add(t *testing.T) {
+	plain := NewBoard(8, 8)
+	cylinder := plain.WithWrap(0)
+	torus := plain.WithWrap(0, 1)
+
+	tests := []struct {
+		board  Board
+		from   string
+		delta  Delta
+		want   string
+		wantOK bool
+	}{
+		{plain, "e4", NewDelta(1, 1), "f5", true},
+		{plain, "h4", NewDelta(1, 0), "", false},
+		{plain, "i4", NewDelta(-1, 0), "", false},
+		{cylinder, "h4", NewDelta(1, 0), "a4", true},
+		{cylinder, "a4", NewDelta(-3, 0), "f4", true},
+		{cylinder, "a4", NewDelta(16, 0), "a4", true},
+		{cylinder, "a8", NewDelta(0, 1), "", false},
+		{torus, "h8", NewDelta(1, 1), "a1", true},
+		{torus, "a1", NewDelta(-1, -2), "h7", true},
+		{torus, "a1", NewDelta(1, 1, 1), "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.board.Add(MustParse(tt.from), tt.delta)
+		if ok != tt.wantOK {
+			t.Errorf("Add(%s, %s) ok = %v, want %v", tt.from, tt.delta, ok, tt.wantOK)
+			continue
+		}
+		if ok && got.String() != tt.want {
+			t.Errorf("Add(%s, %s) = %s, want %s", tt.from, tt.delta, got, tt.want)
+		}
+	}
+}
+
+func TestBoard_Sub(t *testing.T) {
+	torus := NewBoard(8, 7).WithWrap(0, 1)
+
+	tests := []struct {
+		a, b string
+		want Delta
+	}{
+		{"b1", "a1", NewDelta(1, 0)},
+		{"h1", "a1", NewDelta(-1, 0)},
+		{"a1", "h1", NewDelta(1, 0)},
+		{"e1", "a1", NewDelta(4, 0)},
+		{"a1", "e1", NewDelta(4, 0)},
+		{"a7", "a1", NewDelta(0, -1)},
+		{"a4", "a1", NewDelta(0, 3)},
+		{"a5", "a1", NewDelta(0, -3)},
+	}
+
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		got := torus.Sub(a, b)
+		if got != tt.want {
+			t.Errorf("Sub(%s, %s) = %s, want %s", a, b, got, tt.want)
+		}
+		if back, ok := torus.Add(b, got); !ok || back != a {
+			t.Errorf("Add(%s, Sub(%s, %s)) = %s, want %s", b, a, b, back, a)
+		}
+	}
+
+	if got := NewBoard(8, 8).Sub(MustParse("h1"), MustParse("a1")); got != NewDelta(7, 0) {
+		t.Errorf("Sub(h1, a1) without wraparound = %s, want (7,0)", got)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Distances
+// ----------------------------------------------------------------------------
+
+func TestBoard_Distances(t *testing.T) {
+	torus := NewBoard(8, 8).WithWrap(0, 1)
+	plain := NewBoard(8, 8)
+	a1, h8 := MustParse("a1"), MustParse("h8")
+
+	if got := torus.Manhattan(a1, h8); got != 2 {
+		t.Errorf("torus Manhattan(a1, h8) = %d, want 2", got)
+	}
+	if got := torus.Chebyshev(a1, h8); got != 1 {
+		t.Errorf("torus Chebyshev(a1, h8) = %d, want 1", got)
+	}
+	if got := torus.EuclideanSquared(a1, h8); got != 2 {
+		t.Errorf("torus EuclideanSquared(a1, h8) = %d, want 2", got)
+	}
+	if got := plain.Manhattan(a1, h8); got != Manhattan(a1, h8) {
+		t.Errorf("plain Manhattan(a1, h8) = %d, want %d", got, Manhattan(a1, h8))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Neighbours, rays and leapers
+// ----------------------------------------------------------------------------
+
+func TestBoard_Wrap_Neighbors(t *testing.T) {
+	cylinder := NewBoard(8, 8).WithWrap(0)
+	torus := NewBoard(8, 8).WithWrap(0, 1)
+
+	got := collectStrings(cylinder.Neighbors(MustParse("a1")))
+	want := []string{"h1", "b1", "a2"}
+	if !equalStrings(got, want) {
+		t.Errorf("cylinder Neighbors(a1) = %v, want %v", got, want)
+	}
+
+	if n := len(collectStrings(torus.MooreNeighbors(MustParse("a1")))); n != 8 {
+		t.Errorf("torus MooreNeighbors(a1) yielded %d cells, want 8", n)
+	}
+}
+
+func TestBoard_Wrap_Neighbors_SmallBoard(t *testing.T) {
+	// On a 2-wide ring, both horizontal neighbours are the same cell.
+	ring := NewBoard(2, 3).WithWrap(0)
+	got := collectStrings(ring.Neighbors(MustParse("a2")))
+	want := []string{"a1", "b2", "a3"}
+	if !equalStrings(got, want) {
+		t.Errorf("Neighbors(a2) on 2x3 ring = %v, want %v", got, want)
+	}
+
+	// On a 1-wide ring, the cell is its own neighbour and is skipped.
+	single := NewBoard(1).WithWrap(0)
+	if got := collectStrings(single.Neighbors(MustParse("a"))); len(got) != 0 {
+		t.Errorf("Neighbors(a) on 1-cell ring = %v, want none", got)
+	}
+}
+
+func TestBoard_Wrap_Ray(t *testing.T) {
+	cylinder := NewBoard(8, 8).WithWrap(0)
+	torus := NewBoard(4, 3).WithWrap(0, 1)
+
+	tests := []struct {
+		board Board
+		from  string
+		dir   Delta
+		want  []string
+	}{
+		{cylinder, "f1", NewDelta(1, 0), []string{"g1", "h1", "a1", "b1", "c1", "d1", "e1"}},
+		{cylinder, "g1", NewDelta(1, 1), []string{"h2", "a3", "b4", "c5", "d6", "e7", "f8"}},
+		{torus, "a1", NewDelta(1, 1), []string{"b2", "c3", "d1", "a2", "b3", "c1", "d2", "a3", "b1", "c2", "d3"}},
+	}
+
+	for _, tt := range tests {
+		got := collectStrings(tt.board.Ray(MustParse(tt.from), tt.dir))
+		if !equalStrings(got, tt.want) {
+			t.Errorf("Ray(%s, %s) = %v, want %v", tt.from, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestBoard_Wrap_Leaps(t *testing.T) {
+	cylinder := NewBoard(8, 8).WithWrap(0)
+
+	got := collectStrings(cylinder.Leaps(MustParse("a1"), 1, 2))
+	want := []string{"g2", "c2", "h3", "b3"}
+	if !equalStrings(got, want) {
+		t.Errorf("cylinder Leaps(a1, 1, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestBoard_Wrap_JumpsDedup(t *testing.T) {
+	ring := NewBoard(2).WithWrap(0)
+	deltas := []Delta{NewDelta(-1), NewDelta(1), NewDelta(2)}
+
+	got := collectStrings(ring.Jumps(MustParse("a"), deltas))
+	want := []string{"b"}
+	if !equalStrings(got, want) {
+		t.Errorf("ring Jumps(a, [-1 1 2]) = %v, want %v", got, want)
+	}
+}
+
+func TestBoard_Wrap_Symmetries(t *testing.T) {
+	cylinder := NewBoard(8, 8).WithWrap(0)
+	if n := len(cylinder.Symmetries()); n != 4 {
+		t.Errorf("len(cylinder Symmetries()) = %d, want 4", n)
+	}
+
+	torus := NewBoard(8, 8).WithWrap(0, 1)
+	if n := len(torus.Symmetries()); n != 8 {
+		t.Errorf("len(torus Symmetries()) = %d, want 8", n)
+	}
+}